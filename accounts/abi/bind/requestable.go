@@ -0,0 +1,444 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package bind
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/Onther-Tech/plasma-evm/accounts/abi"
+)
+
+// StorageVariable annotates a state variable of a requestable contract with its
+// storage layout, so that enter and exit requests can address it by trie key.
+type StorageVariable struct {
+	Name  string `json:"name"`          // Name of the state variable
+	Slot  uint64 `json:"slot"`          // Storage slot assigned by the Solidity compiler
+	Key   string `json:"key,omitempty"` // Key type if the variable is a mapping
+	Value string `json:"value"`         // Type of the stored value
+}
+
+// identifierRegex matches the state variable names accepted in annotations.
+var identifierRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// BindRequestable generates Go helpers around requestable contracts, i.e. ones
+// implementing applyRequestInRootChain and applyRequestInChildChain. For every
+// annotated state variable the helpers compute the trie keys and values, make
+// enter and exit requests through the RootChain contract and decode applied
+// requests back to the variable they update.
+func BindRequestable(types []string, abis []string, storage map[string][]StorageVariable, pkg string) (string, error) {
+	contracts := make(map[string]*tmplRequestable)
+
+	for i := 0; i < len(types); i++ {
+		evmABI, err := abi.JSON(strings.NewReader(abis[i]))
+		if err != nil {
+			return "", err
+		}
+		for _, method := range []string{"applyRequestInRootChain", "applyRequestInChildChain"} {
+			if _, ok := evmABI.Methods[method]; !ok {
+				return "", fmt.Errorf("contract %s is not requestable: missing %s", types[i], method)
+			}
+		}
+		variables, err := bindStorage(evmABI, storage[types[i]])
+		if err != nil {
+			return "", fmt.Errorf("contract %s: %v", types[i], err)
+		}
+		contracts[types[i]] = &tmplRequestable{
+			Type:      capitalise(types[i]),
+			Variables: variables,
+		}
+	}
+	for name := range storage {
+		if _, ok := contracts[name]; !ok {
+			return "", fmt.Errorf("storage annotated for unknown contract %s", name)
+		}
+	}
+	data := &tmplRequestableData{
+		Package:   pkg,
+		Contracts: contracts,
+	}
+	buffer := new(bytes.Buffer)
+
+	funcs := map[string]interface{}{
+		"bindtype": bindTypeGo,
+	}
+	tmpl := template.Must(template.New("").Funcs(funcs).Parse(tmplSourceRequestableGo))
+	if err := tmpl.Execute(buffer, data); err != nil {
+		return "", err
+	}
+	code, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("%v\n%s", err, buffer)
+	}
+	return string(code), nil
+}
+
+// bindStorage validates the storage annotations of a contract, cross checking
+// them against the public getters of its ABI where those exist.
+func bindStorage(evmABI abi.ABI, annotations []StorageVariable) ([]*tmplVariable, error) {
+	var (
+		variables []*tmplVariable
+		names     = make(map[string]bool)
+		slots     = make(map[uint64]string)
+	)
+	for _, annotation := range annotations {
+		if !identifierRegex.MatchString(annotation.Name) {
+			return nil, fmt.Errorf("invalid state variable name %q", annotation.Name)
+		}
+		normalized := capitalise(annotation.Name)
+		if names[normalized] {
+			return nil, fmt.Errorf("duplicate state variable %s", annotation.Name)
+		}
+		names[normalized] = true
+
+		if other, ok := slots[annotation.Slot]; ok {
+			return nil, fmt.Errorf("state variables %s and %s share slot %d", other, annotation.Name, annotation.Slot)
+		}
+		slots[annotation.Slot] = annotation.Name
+
+		value, err := storageType(annotation.Value)
+		if err != nil {
+			return nil, fmt.Errorf("state variable %s: %v", annotation.Name, err)
+		}
+		variable := &tmplVariable{
+			Name:  normalized,
+			Slot:  annotation.Slot,
+			Value: value,
+		}
+		if annotation.Key != "" {
+			key, err := storageType(annotation.Key)
+			if err != nil {
+				return nil, fmt.Errorf("state variable %s: %v", annotation.Name, err)
+			}
+			variable.Mapping, variable.Key = true, key
+		}
+		// Public state variables have a getter in the ABI, make sure it agrees
+		if getter, ok := evmABI.Methods[annotation.Name]; ok {
+			if len(getter.Outputs) != 1 || getter.Outputs[0].Type.String() != value.String() {
+				return nil, fmt.Errorf("state variable %s: value type %s does not match getter", annotation.Name, value)
+			}
+			switch {
+			case !variable.Mapping && len(getter.Inputs) != 0:
+				return nil, fmt.Errorf("state variable %s: getter takes a key but no key type is annotated", annotation.Name)
+			case variable.Mapping && (len(getter.Inputs) != 1 || getter.Inputs[0].Type.String() != variable.Key.String()):
+				return nil, fmt.Errorf("state variable %s: key type %s does not match getter", annotation.Name, variable.Key)
+			}
+		}
+		variables = append(variables, variable)
+	}
+	return variables, nil
+}
+
+// storageType parses a Solidity type that fits in a single storage word.
+func storageType(kind string) (abi.Type, error) {
+	typ, err := abi.NewType(kind)
+	if err != nil {
+		return abi.Type{}, err
+	}
+	switch typ.T {
+	case abi.IntTy, abi.UintTy, abi.BoolTy, abi.AddressTy, abi.FixedBytesTy:
+		return typ, nil
+	}
+	return abi.Type{}, fmt.Errorf("type %s does not fit in a storage word", kind)
+}
+
+// tmplRequestableData is the data structure required to fill the requestable
+// helper template.
+type tmplRequestableData struct {
+	Package   string                      // Name of the package to place the generated file in
+	Contracts map[string]*tmplRequestable // List of contracts to generate helpers for
+}
+
+// tmplRequestable contains the data needed to generate the helpers of an
+// individual requestable contract.
+type tmplRequestable struct {
+	Type      string          // Type name of the main contract binding
+	Variables []*tmplVariable // Annotated state variables reachable by requests
+}
+
+// tmplVariable is an annotated state variable of a requestable contract.
+type tmplVariable struct {
+	Name    string   // Capitalised name of the state variable
+	Slot    uint64   // Storage slot of the variable
+	Mapping bool     // Whether the variable is a mapping
+	Key     abi.Type // Key type of a mapping
+	Value   abi.Type // Type of the stored value
+}
+
+// tmplSourceRequestableGo is the Go source template used to generate the
+// requestable contract helpers based on.
+const tmplSourceRequestableGo = `
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package {{.Package}}
+
+import (
+	"math/big"
+
+	"github.com/Onther-Tech/plasma-evm/accounts/abi"
+	"github.com/Onther-Tech/plasma-evm/accounts/abi/bind"
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/crypto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = abi.U256
+	_ = bind.Bind
+	_ = common.Big1
+	_ = rootchain.NewRootChain
+	_ = types.BloomLookup
+	_ = crypto.Keccak256Hash
+)
+
+{{range $contract := .Contracts}}
+	// {{.Type}}SlotTrieKey returns the trie key of the state variable stored in slot.
+	func {{.Type}}SlotTrieKey(slot uint64) common.Hash {
+		return common.BigToHash(new(big.Int).SetUint64(slot))
+	}
+
+	// {{.Type}}MappingTrieKey returns the trie key of the mapping entry at key of
+	// the mapping stored in slot.
+	func {{.Type}}MappingTrieKey(slot uint64, key common.Hash) common.Hash {
+		return crypto.Keccak256Hash(key.Bytes(), {{.Type}}SlotTrieKey(slot).Bytes())
+	}
+
+	// pack{{.Type}}Word packs a value of the given Solidity type into an ABI encoded
+	// word, the way mapping keys are hashed. The types are validated when generating,
+	// so failures are programming errors.
+	func pack{{.Type}}Word(kind string, value interface{}) common.Hash {
+		typ, err := abi.NewType(kind)
+		if err != nil {
+			panic(err)
+		}
+		packed, err := abi.Arguments{ {Type: typ} }.Pack(value)
+		if err != nil {
+			panic(err)
+		}
+		return common.BytesToHash(packed)
+	}
+
+	// pack{{.Type}}StorageWord packs a value of the given Solidity type into a storage
+	// word. Unlike in ABI encoding, fixed size byte arrays are right-aligned and signed
+	// integers are not sign extended.
+	func pack{{.Type}}StorageWord(kind string, value interface{}) common.Hash {
+		typ, err := abi.NewType(kind)
+		if err != nil {
+			panic(err)
+		}
+		word := pack{{.Type}}Word(kind, value)
+		switch {
+		case typ.T == abi.FixedBytesTy:
+			return common.BytesToHash(word[:typ.Size])
+		case typ.T == abi.IntTy && typ.Size < 256:
+			return common.BytesToHash(word[common.HashLength-typ.Size/8:])
+		}
+		return word
+	}
+
+	// unpack{{.Type}}StorageWord unpacks a value of the given Solidity type from a storage word.
+	func unpack{{.Type}}StorageWord(kind string, word common.Hash) (interface{}, error) {
+		typ, err := abi.NewType(kind)
+		if err != nil {
+			return nil, err
+		}
+		packed := word
+		switch {
+		case typ.T == abi.FixedBytesTy:
+			packed = common.Hash{}
+			copy(packed[:], word[common.HashLength-typ.Size:])
+		case typ.T == abi.IntTy && typ.Size < 256:
+			start := common.HashLength - typ.Size/8
+			for i := 0; i < start; i++ {
+				packed[i] = 0
+				if word[start]&0x80 != 0 {
+					packed[i] = 0xff
+				}
+			}
+		}
+		values, err := abi.Arguments{ {Type: typ} }.UnpackValues(packed.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		return values[0], nil
+	}
+
+	{{range .Variables}}
+		{{if .Mapping}}
+			// {{$contract.Type}}{{.Name}}TrieKey returns the trie key of the {{.Name}} entry at key.
+			func {{$contract.Type}}{{.Name}}TrieKey(key {{bindtype .Key}}) common.Hash {
+				return {{$contract.Type}}MappingTrieKey({{.Slot}}, pack{{$contract.Type}}Word("{{.Key}}", key))
+			}
+		{{else}}
+			// {{$contract.Type}}{{.Name}}TrieKey returns the trie key of {{.Name}}.
+			func {{$contract.Type}}{{.Name}}TrieKey() common.Hash {
+				return {{$contract.Type}}SlotTrieKey({{.Slot}})
+			}
+		{{end}}
+
+		// {{$contract.Type}}{{.Name}}TrieValue returns the trie value storing value in {{.Name}}.
+		func {{$contract.Type}}{{.Name}}TrieValue(value {{bindtype .Value}}) common.Hash {
+			return pack{{$contract.Type}}StorageWord("{{.Value}}", value)
+		}
+
+		// {{$contract.Type}}{{.Name}}FromTrieValue decodes a trie value of {{.Name}}.
+		func {{$contract.Type}}{{.Name}}FromTrieValue(trieValue common.Hash) ({{bindtype .Value}}, error) {
+			value, err := unpack{{$contract.Type}}StorageWord("{{.Value}}", trieValue)
+			if err != nil {
+				return *new({{bindtype .Value}}), err
+			}
+			return value.({{bindtype .Value}}), nil
+		}
+	{{end}}
+
+	// {{.Type}}Requests makes enter and exit requests to a {{.Type}} contract through
+	// the RootChain contract, and decodes the requests applied to it.
+	type {{.Type}}Requests struct {
+		Contract  common.Address       // Address of the requestable contract on the root chain
+		RootChain *rootchain.RootChain // RootChain contract the requests are made to
+	}
+
+	// New{{.Type}}Requests creates request helpers for the {{.Type}} contract at contract,
+	// bound to the RootChain contract at rootchainAddress.
+	func New{{.Type}}Requests(contract, rootchainAddress common.Address, backend bind.ContractBackend) (*{{.Type}}Requests, error) {
+		rootchainContract, err := rootchain.NewRootChain(rootchainAddress, backend)
+		if err != nil {
+			return nil, err
+		}
+		return &{{.Type}}Requests{Contract: contract, RootChain: rootchainContract}, nil
+	}
+
+	// StartEnter makes an enter request moving trieValue at trieKey from the root
+	// chain to the child chain.
+	func (r *{{.Type}}Requests) StartEnter(opts *bind.TransactOpts, trieKey, trieValue common.Hash) (*types.Transaction, error) {
+		return r.RootChain.StartEnter(opts, false, r.Contract, trieKey, trieValue)
+	}
+
+	// StartExit makes an exit request moving trieValue at trieKey from the child
+	// chain back to the root chain. The exit cost must be paid in opts.Value.
+	func (r *{{.Type}}Requests) StartExit(opts *bind.TransactOpts, trieKey, trieValue common.Hash) (*types.Transaction, error) {
+		return r.RootChain.StartExit(opts, r.Contract, trieKey, trieValue)
+	}
+
+	{{range .Variables}}
+		// Enter{{.Name}} makes an enter request for value of {{.Name}}{{if .Mapping}} at key{{end}}.
+		func (r *{{$contract.Type}}Requests) Enter{{.Name}}(opts *bind.TransactOpts{{if .Mapping}}, key {{bindtype .Key}}{{end}}, value {{bindtype .Value}}) (*types.Transaction, error) {
+			return r.StartEnter(opts, {{$contract.Type}}{{.Name}}TrieKey({{if .Mapping}}key{{end}}), {{$contract.Type}}{{.Name}}TrieValue(value))
+		}
+
+		// Exit{{.Name}} makes an exit request for value of {{.Name}}{{if .Mapping}} at key{{end}}.
+		func (r *{{$contract.Type}}Requests) Exit{{.Name}}(opts *bind.TransactOpts{{if .Mapping}}, key {{bindtype .Key}}{{end}}, value {{bindtype .Value}}) (*types.Transaction, error) {
+			return r.StartExit(opts, {{$contract.Type}}{{.Name}}TrieKey({{if .Mapping}}key{{end}}), {{$contract.Type}}{{.Name}}TrieValue(value))
+		}
+	{{end}}
+
+	// {{.Type}}RequestApplied is a request to the {{.Type}} contract applied by the
+	// RootChain contract.
+	type {{.Type}}RequestApplied struct {
+		RequestId     *big.Int
+		UserActivated bool
+		IsExit        bool
+		Requestor     common.Address
+		TrieKey       common.Hash
+		TrieValue     common.Hash
+		Variable      string    // Annotated state variable updated by the request, if known
+		Raw           types.Log // Blockchain specific contextual infos
+	}
+
+	// FilterRequestApplied retrieves the applied requests made to the {{.Type}} contract.
+	func (r *{{.Type}}Requests) FilterRequestApplied(opts *bind.FilterOpts) ([]*{{.Type}}RequestApplied, error) {
+		it, err := r.RootChain.FilterRequestApplied(opts)
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+
+		var applied []*{{.Type}}RequestApplied
+		for it.Next() {
+			request, err := r.DecodeRequestApplied(&bind.CallOpts{Context: opts.Context}, it.Event)
+			if err != nil {
+				return nil, err
+			}
+			if request != nil {
+				applied = append(applied, request)
+			}
+		}
+		return applied, it.Error()
+	}
+
+	// DecodeRequestApplied looks up the request of a RequestApplied event. It returns
+	// nil if the request was made to another contract.
+	func (r *{{.Type}}Requests) DecodeRequestApplied(opts *bind.CallOpts, event *rootchain.RootChainRequestApplied) (*{{.Type}}RequestApplied, error) {
+		var (
+			request struct {
+				Timestamp  uint64
+				IsExit     bool
+				IsTransfer bool
+				Finalized  bool
+				Challenged bool
+				Value      *big.Int
+				Requestor  common.Address
+				To         common.Address
+				TrieKey    [32]byte
+				TrieValue  [32]byte
+				Hash       [32]byte
+			}
+			err error
+		)
+		if event.UserActivated {
+			request, err = r.RootChain.ERUs(opts, event.RequestId)
+		} else {
+			request, err = r.RootChain.EROs(opts, event.RequestId)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if request.IsTransfer || request.To != r.Contract {
+			return nil, nil
+		}
+		applied := &{{.Type}}RequestApplied{
+			RequestId:     event.RequestId,
+			UserActivated: event.UserActivated,
+			IsExit:        request.IsExit,
+			Requestor:     request.Requestor,
+			TrieKey:       common.Hash(request.TrieKey),
+			TrieValue:     common.Hash(request.TrieValue),
+			Raw:           event.Raw,
+		}
+		switch applied.TrieKey {
+		{{range .Variables}}
+			{{if not .Mapping}}
+				case {{$contract.Type}}{{.Name}}TrieKey():
+					applied.Variable = "{{.Name}}"
+			{{else if eq .Key.String "address"}}
+				case {{$contract.Type}}{{.Name}}TrieKey(applied.Requestor):
+					applied.Variable = "{{.Name}}"
+			{{end}}
+		{{end}}
+		}
+		return applied, nil
+	}
+{{end}}
+`
@@ -0,0 +1,291 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package bind
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+)
+
+const requestableTestABI = `[
+	{"constant":true,"inputs":[],"name":"totalSupply","outputs":[{"name":"","type":"uint256"}],"type":"function"},
+	{"constant":true,"inputs":[{"name":"","type":"address"}],"name":"balances","outputs":[{"name":"","type":"uint256"}],"type":"function"},
+	{"constant":false,"inputs":[{"name":"isExit","type":"bool"},{"name":"requestId","type":"uint256"},{"name":"requestor","type":"address"},{"name":"trieKey","type":"bytes32"},{"name":"trieValue","type":"bytes32"}],"name":"applyRequestInRootChain","outputs":[{"name":"","type":"bool"}],"type":"function"},
+	{"constant":false,"inputs":[{"name":"isExit","type":"bool"},{"name":"requestId","type":"uint256"},{"name":"requestor","type":"address"},{"name":"trieKey","type":"bytes32"},{"name":"trieValue","type":"bytes32"}],"name":"applyRequestInChildChain","outputs":[{"name":"","type":"bool"}],"type":"function"}
+]`
+
+// requestableTestStorage annotates the state variables of requestableTestABI,
+// covering the value and key types laid out differently in storage and in ABI
+// encoding.
+var requestableTestStorage = map[string][]StorageVariable{
+	"token": {
+		{Name: "tag", Slot: 0, Value: "bytes4"},
+		{Name: "delta", Slot: 1, Value: "int8"},
+		{Name: "balances", Slot: 2, Key: "address", Value: "uint256"},
+		{Name: "names", Slot: 3, Key: "bytes4", Value: "uint256"},
+	},
+}
+
+// requestableTestCode is runtime code storing its input in the annotated state
+// variables the way the Solidity compiler does:
+//
+//	tag = bytes4(input[0:32]); delta = int8(input[32:64]);
+//	balances[address(input[64:96])] = input[96:128];
+//	names[bytes4(input[128:160])] = input[160:192];
+var requestableTestCode = "" +
+	"600035" + "7c01" + strings.Repeat("00", 28) + "9004" + "600055" + // sstore(0, div(calldataload(0), 2^224))
+	"602035" + "60ff16" + "600155" + // sstore(1, and(calldataload(32), 0xff))
+	"604035600052" + "6002602052" + "606035" + "6040600020" + "55" + // sstore(keccak256(who, 2), calldataload(96))
+	"608035600052" + "6003602052" + "60a035" + "6040600020" + "55" + // sstore(keccak256(name, 3), calldataload(160))
+	"00"
+
+// requestableTestTester exercises the generated helpers against the storage of
+// requestableTestCode and a RootChain contract faked by the backend.
+const requestableTestTester = `
+package bindtest
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	ethereum "github.com/Onther-Tech/plasma-evm"
+	"github.com/Onther-Tech/plasma-evm/accounts/abi"
+	"github.com/Onther-Tech/plasma-evm/accounts/abi/bind"
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/core/vm/runtime"
+)
+
+var (
+	tag       = [4]byte{0x12, 0x34, 0x56, 0x78}
+	delta     = int8(-2)
+	who       = common.HexToAddress("0x0102030405060708091011121314151617181920")
+	balance   = big.NewInt(1000)
+	name      = [4]byte{0xca, 0xfe, 0xba, 0xbe}
+	nameValue = big.NewInt(7)
+)
+
+func TestTokenStorage(t *testing.T) {
+	var args abi.Arguments
+	for _, kind := range []string{"bytes4", "int8", "address", "uint256", "bytes4", "uint256"} {
+		typ, _ := abi.NewType(kind)
+		args = append(args, abi.Argument{Type: typ})
+	}
+	input, err := args.Pack(tag, delta, who, balance, name, nameValue)
+	if err != nil {
+		t.Fatalf("failed to pack input: %%v", err)
+	}
+	_, statedb, err := runtime.Execute(common.FromHex("%s"), input, nil)
+	if err != nil {
+		t.Fatalf("failed to store input: %%v", err)
+	}
+	contract := common.BytesToAddress([]byte("contract"))
+
+	if have, want := statedb.GetState(contract, TokenTagTrieKey()), TokenTagTrieValue(tag); have != want {
+		t.Errorf("tag trie value mismatch: have %%x, want %%x", have, want)
+	}
+	if have, want := statedb.GetState(contract, TokenDeltaTrieKey()), TokenDeltaTrieValue(delta); have != want {
+		t.Errorf("delta trie value mismatch: have %%x, want %%x", have, want)
+	}
+	if have, want := statedb.GetState(contract, TokenBalancesTrieKey(who)), TokenBalancesTrieValue(balance); have != want {
+		t.Errorf("balance trie value mismatch: have %%x, want %%x", have, want)
+	}
+	if have, want := statedb.GetState(contract, TokenNamesTrieKey(name)), TokenNamesTrieValue(nameValue); have != want {
+		t.Errorf("name trie value mismatch: have %%x, want %%x", have, want)
+	}
+	if have, err := TokenTagFromTrieValue(statedb.GetState(contract, TokenTagTrieKey())); err != nil || have != tag {
+		t.Errorf("tag mismatch: have %%x (%%v), want %%x", have, err, tag)
+	}
+	if have, err := TokenDeltaFromTrieValue(statedb.GetState(contract, TokenDeltaTrieKey())); err != nil || have != delta {
+		t.Errorf("delta mismatch: have %%d (%%v), want %%d", have, err, delta)
+	}
+	if have, err := TokenBalancesFromTrieValue(statedb.GetState(contract, TokenBalancesTrieKey(who))); err != nil || have.Cmp(balance) != 0 {
+		t.Errorf("balance mismatch: have %%v (%%v), want %%v", have, err, balance)
+	}
+}
+
+// testBackend fakes a RootChain contract, serving a single request made to the
+// token contract and recording the transactions sent.
+type testBackend struct {
+	abi     abi.ABI
+	request []interface{}
+	sent    []*types.Transaction
+}
+
+func (b *testBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x00}, nil
+}
+
+func (b *testBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	method, err := b.abi.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(b.request...)
+}
+
+func (b *testBackend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return []byte{0x00}, nil
+}
+
+func (b *testBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return 0, nil
+}
+
+func (b *testBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(1), nil
+}
+
+func (b *testBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return 100000, nil
+}
+
+func (b *testBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	b.sent = append(b.sent, tx)
+	return nil
+}
+
+func (b *testBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, nil
+}
+
+func (b *testBackend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, nil
+}
+
+func TestTokenRequests(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(rootchain.RootChainABI))
+	if err != nil {
+		t.Fatalf("failed to parse RootChain ABI: %%v", err)
+	}
+	contract := common.HexToAddress("0x0100000000000000000000000000000000000000")
+	backend := &testBackend{
+		abi:     parsed,
+		request: []interface{}{uint64(0), true, false, true, false, big.NewInt(0), who, contract, TokenBalancesTrieKey(who), TokenBalancesTrieValue(balance), [32]byte{}},
+	}
+	requests, err := NewTokenRequests(contract, common.HexToAddress("0x0200000000000000000000000000000000000000"), backend)
+	if err != nil {
+		t.Fatalf("failed to create requests: %%v", err)
+	}
+	// Enter requests must reach the RootChain contract with the storage layout
+	opts := &bind.TransactOpts{
+		From:   who,
+		Signer: func(signer types.Signer, addr common.Address, tx *types.Transaction) (*types.Transaction, error) { return tx, nil },
+	}
+	if _, err := requests.EnterTag(opts, tag); err != nil {
+		t.Fatalf("failed to make enter request: %%v", err)
+	}
+	args, err := parsed.Methods["startEnter"].Inputs.UnpackValues(backend.sent[0].Data()[4:])
+	if err != nil {
+		t.Fatalf("failed to unpack enter request: %%v", err)
+	}
+	if args[0].(bool) || args[1].(common.Address) != contract {
+		t.Errorf("enter request target mismatch: have %%v %%x, want false %%x", args[0], args[1], contract)
+	}
+	if key, value := common.Hash(args[2].([32]byte)), common.Hash(args[3].([32]byte)); key != TokenTagTrieKey() || value != TokenTagTrieValue(tag) {
+		t.Errorf("enter request trie mismatch: have %%x=%%x, want %%x=%%x", key, value, TokenTagTrieKey(), TokenTagTrieValue(tag))
+	}
+	// Applied requests must be decoded back to the state variable they update
+	applied, err := requests.DecodeRequestApplied(&bind.CallOpts{}, &rootchain.RootChainRequestApplied{RequestId: big.NewInt(3)})
+	if err != nil {
+		t.Fatalf("failed to decode applied request: %%v", err)
+	}
+	if applied == nil || applied.Variable != "Balances" || !applied.IsExit || applied.Requestor != who {
+		t.Fatalf("applied request mismatch: have %%+v", applied)
+	}
+	if value, err := TokenBalancesFromTrieValue(applied.TrieValue); err != nil || value.Cmp(balance) != 0 {
+		t.Errorf("applied balance mismatch: have %%v (%%v), want %%v", value, err, balance)
+	}
+	// Requests made to other contracts must be skipped
+	backend.request[7] = common.HexToAddress("0x0300000000000000000000000000000000000000")
+	if applied, err := requests.DecodeRequestApplied(&bind.CallOpts{}, &rootchain.RootChainRequestApplied{RequestId: big.NewInt(3)}); err != nil || applied != nil {
+		t.Errorf("foreign request decoded: have %%+v (%%v), want nil", applied, err)
+	}
+}
+`
+
+// Tests that the generated requestable helpers compile and lay out trie keys and
+// values the way the contract storage does.
+func TestBindRequestable(t *testing.T) {
+	// Skip the test if no Go command can be found
+	gocmd := runtime.GOROOT() + "/bin/go"
+	if !common.FileExist(gocmd) {
+		t.Skip("go sdk not found for testing")
+	}
+	// Create a temporary workspace for the test suite
+	ws, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("failed to create temporary workspace: %v", err)
+	}
+	defer os.RemoveAll(ws)
+
+	pkg := filepath.Join(ws, "bindtest")
+	if err = os.MkdirAll(pkg, 0700); err != nil {
+		t.Fatalf("failed to create package: %v", err)
+	}
+	code, err := BindRequestable([]string{"token"}, []string{requestableTestABI}, requestableTestStorage, "bindtest")
+	if err != nil {
+		t.Fatalf("failed to generate helpers: %v", err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(pkg, "token.go"), []byte(code), 0600); err != nil {
+		t.Fatalf("failed to write helpers: %v", err)
+	}
+	tester := fmt.Sprintf(requestableTestTester, requestableTestCode)
+	if err = ioutil.WriteFile(filepath.Join(pkg, "token_test.go"), []byte(tester), 0600); err != nil {
+		t.Fatalf("failed to write tests: %v", err)
+	}
+	// Test the generated package and report any failures
+	cmd := exec.Command(gocmd, "test", "-v", "-count", "1")
+	cmd.Dir = pkg
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("failed to run requestable test: %v\n%s", err, out)
+	}
+}
+
+// Tests that invalid contracts and storage annotations are rejected.
+func TestBindRequestableErrors(t *testing.T) {
+	tests := []struct {
+		abi     string
+		storage []StorageVariable
+		err     string
+	}{
+		{`[]`, nil, "not requestable"},
+		{requestableTestABI, []StorageVariable{{Name: "balances", Slot: 2, Key: "address", Value: "uint128"}}, "value type"},
+		{requestableTestABI, []StorageVariable{{Name: "balances", Slot: 2, Key: "uint256", Value: "uint256"}}, "key type"},
+		{requestableTestABI, []StorageVariable{{Name: "balances", Slot: 2, Value: "uint256"}}, "no key type"},
+		{requestableTestABI, []StorageVariable{{Name: "names", Slot: 3, Key: "address", Value: "string"}}, "storage word"},
+		{requestableTestABI, []StorageVariable{{Name: "a", Slot: 3, Value: "bool"}, {Name: "b", Slot: 3, Value: "bool"}}, "share slot"},
+		{requestableTestABI, []StorageVariable{{Name: "a-b", Slot: 3, Value: "bool"}}, "invalid state variable name"},
+	}
+	for i, tt := range tests {
+		_, err := BindRequestable([]string{"token"}, []string{tt.abi}, map[string][]StorageVariable{"token": tt.storage}, "bindtest")
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("test %d: error mismatch: have %v, want %q", i, err, tt.err)
+		}
+	}
+}
@@ -40,6 +40,9 @@ var (
 	pkgFlag  = flag.String("pkg", "", "Package name to generate the binding into")
 	outFlag  = flag.String("out", "", "Output file for the generated binding (default = stdout)")
 	langFlag = flag.String("lang", "go", "Destination language for the bindings (go, java, objc)")
+
+	reqFlag     = flag.Bool("requestable", false, "Generate enter and exit request helpers of requestable contracts instead of the binding")
+	storageFlag = flag.String("storage", "", "Path to the JSON storage layout annotations of the requestable contracts")
 )
 
 func main() {
@@ -69,6 +72,13 @@ func main() {
 		fmt.Printf("Unsupported destination language \"%s\" (--lang)\n", *langFlag)
 		os.Exit(-1)
 	}
+	if *reqFlag && lang != bind.LangGo {
+		fmt.Printf("Requestable helpers (--requestable) can only be generated in Go\n")
+		os.Exit(-1)
+	} else if *storageFlag != "" && !*reqFlag {
+		fmt.Printf("Storage annotations (--storage) need requestable helpers (--requestable)\n")
+		os.Exit(-1)
+	}
 	// If the entire solidity code was specified, build and bind based on that
 	var (
 		abis  []string
@@ -139,8 +149,23 @@ func main() {
 		}
 		types = append(types, kind)
 	}
-	// Generate the contract binding
-	code, err := bind.Bind(types, abis, bins, *pkgFlag, lang)
+	// Generate the contract binding, or the requestable helpers if requested
+	var (
+		code string
+		err  error
+	)
+	if *reqFlag {
+		var storage map[string][]bind.StorageVariable
+		if *storageFlag != "" {
+			if storage, err = storageFromFile(*storageFlag, types); err != nil {
+				fmt.Printf("Failed to read storage annotations: %v\n", err)
+				os.Exit(-1)
+			}
+		}
+		code, err = bind.BindRequestable(types, abis, storage, *pkgFlag)
+	} else {
+		code, err = bind.Bind(types, abis, bins, *pkgFlag, lang)
+	}
 	if err != nil {
 		fmt.Printf("Failed to generate ABI binding: %v\n", err)
 		os.Exit(-1)
@@ -163,3 +188,25 @@ func contractsFromStdin() (map[string]*compiler.Contract, error) {
 	}
 	return compiler.ParseCombinedJSON(bytes, "", "", "", "")
 }
+
+// storageFromFile loads the storage layout annotations of requestable contracts.
+// The file holds the annotations keyed by contract type, or just a list of them
+// if a single contract is bound.
+func storageFromFile(path string, types []string) (map[string][]bind.StorageVariable, error) {
+	blob, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var variables []bind.StorageVariable
+	if err := json.Unmarshal(blob, &variables); err == nil {
+		if len(types) != 1 {
+			return nil, fmt.Errorf("annotation list is ambiguous for %d contracts", len(types))
+		}
+		return map[string][]bind.StorageVariable{types[0]: variables}, nil
+	}
+	storage := make(map[string][]bind.StorageVariable)
+	if err := json.Unmarshal(blob, &storage); err != nil {
+		return nil, err
+	}
+	return storage, nil
+}
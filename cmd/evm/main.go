@@ -139,6 +139,7 @@ func init() {
 		disasmCommand,
 		runCommand,
 		stateTestCommand,
+		plasmaReplayCommand,
 	}
 }
 
@@ -0,0 +1,341 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/common/hexutil"
+	"github.com/Onther-Tech/plasma-evm/consensus"
+	"github.com/Onther-Tech/plasma-evm/consensus/clique"
+	"github.com/Onther-Tech/plasma-evm/consensus/cliqueplasma"
+	"github.com/Onther-Tech/plasma-evm/consensus/ethash"
+	"github.com/Onther-Tech/plasma-evm/core"
+	"github.com/Onther-Tech/plasma-evm/core/state"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/core/vm"
+	"github.com/Onther-Tech/plasma-evm/ethdb"
+	"github.com/Onther-Tech/plasma-evm/log"
+	"github.com/Onther-Tech/plasma-evm/params"
+	"github.com/Onther-Tech/plasma-evm/rlp"
+
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+var (
+	PlasmaStateFlag = cli.StringFlag{
+		Name:  "state",
+		Usage: "JSON file with the pre-state dump (state.Dump format)",
+	}
+	PlasmaBlockFlag = cli.StringFlag{
+		Name:  "block",
+		Usage: "File with the hex encoded RLP of the plasma block to replay",
+	}
+	PlasmaRequestsFlag = cli.StringFlag{
+		Name:  "requests",
+		Usage: "JSON file with a list of hex encoded ERO bytes to replay as a request block",
+	}
+	PlasmaConfigFlag = cli.StringFlag{
+		Name:  "config",
+		Usage: "JSON file with the chain config (default = plasma chain config)",
+	}
+	PlasmaNumberFlag = cli.Uint64Flag{
+		Name:  "number",
+		Usage: "Block number of the replayed request block",
+		Value: 1,
+	}
+)
+
+var plasmaReplayCommand = cli.Command{
+	Action: plasmaReplayCmd,
+	Name:   "plasma-replay",
+	Usage:  "replays a plasma block or a list of requests on a pre-state",
+	Flags: []cli.Flag{
+		PlasmaStateFlag,
+		PlasmaBlockFlag,
+		PlasmaRequestsFlag,
+		PlasmaConfigFlag,
+		PlasmaNumberFlag,
+	},
+	Description: `
+The plasma-replay command executes the transactions of a plasma block, or the
+request transactions made of ERO bytes, on top of a pre-state dump. It prints
+the post-state root, the receipts and the binary Merkle roots of transactions
+and receipts, which can be compared with the roots submitted to the RootChain
+contract. Block hashes are not available, so BLOCKHASH returns zero.`,
+}
+
+// PlasmaReplayResult contains the roots and receipts after replaying a plasma
+// block, the roots of the replayed block header that differ, and a dump of the
+// final state if requested.
+type PlasmaReplayResult struct {
+	PreStateRoot     common.Hash    `json:"preStateRoot"`
+	StateRoot        common.Hash    `json:"stateRoot"`
+	TransactionsRoot common.Hash    `json:"transactionsRoot"`
+	ReceiptsRoot     common.Hash    `json:"receiptsRoot"`
+	GasUsed          uint64         `json:"gasUsed"`
+	Receipts         types.Receipts `json:"receipts"`
+	Mismatches       []string       `json:"mismatches,omitempty"`
+	State            *state.Dump    `json:"state,omitempty"`
+}
+
+func plasmaReplayCmd(ctx *cli.Context) error {
+	if ctx.String(PlasmaStateFlag.Name) == "" {
+		return errors.New("pre-state dump (--state) required")
+	}
+	if (ctx.String(PlasmaBlockFlag.Name) == "") == (ctx.String(PlasmaRequestsFlag.Name) == "") {
+		return errors.New("either a plasma block (--block) or requests (--requests) required")
+	}
+	// Configure the go-ethereum logger
+	glogger := log.NewGlogHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(false)))
+	glogger.Verbosity(log.Lvl(ctx.GlobalInt(VerbosityFlag.Name)))
+	log.Root().SetHandler(glogger)
+
+	config := params.PlasmaChainConfig
+	if file := ctx.String(PlasmaConfigFlag.Name); file != "" {
+		src, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		config = new(params.ChainConfig)
+		if err := json.Unmarshal(src, config); err != nil {
+			return fmt.Errorf("invalid chain config: %v", err)
+		}
+	}
+	statedb, err := loadStateDump(ctx.String(PlasmaStateFlag.Name))
+	if err != nil {
+		return err
+	}
+	var (
+		header *types.Header
+		txs    types.Transactions
+	)
+	if file := ctx.String(PlasmaBlockFlag.Name); file != "" {
+		block, err := loadPlasmaBlock(file)
+		if err != nil {
+			return err
+		}
+		header, txs = block.Header(), block.Transactions()
+	} else {
+		if txs, err = loadRequests(ctx.String(PlasmaRequestsFlag.Name)); err != nil {
+			return err
+		}
+		header = &types.Header{
+			Number:     new(big.Int).SetUint64(ctx.Uint64(PlasmaNumberFlag.Name)),
+			Difficulty: new(big.Int),
+			Time:       new(big.Int),
+			Coinbase:   params.Operator,
+		}
+		for _, tx := range txs {
+			header.GasLimit += tx.Gas()
+		}
+	}
+
+	var tracer vm.Tracer
+	if ctx.GlobalBool(MachineFlag.Name) {
+		tracer = vm.NewJSONLogger(&vm.LogConfig{
+			DisableMemory: ctx.GlobalBool(DisableMemoryFlag.Name),
+			DisableStack:  ctx.GlobalBool(DisableStackFlag.Name),
+		}, os.Stderr)
+	}
+	cfg := vm.Config{
+		Tracer: tracer,
+		Debug:  tracer != nil,
+	}
+	result, err := replayPlasmaBlock(config, statedb, header, txs, cfg)
+	if err != nil {
+		return err
+	}
+	if ctx.GlobalBool(DumpFlag.Name) {
+		dump := statedb.RawDump()
+		result.State = &dump
+	}
+	out, _ := json.MarshalIndent(result, "", "  ")
+	fmt.Println(string(out))
+	return nil
+}
+
+// replayPlasmaBlock applies the transactions on the state through the same path
+// as the state processor, and finalizes the block with the engine of the chain
+// config. The roots of the header are compared with the replayed ones unless
+// the header was made up for a request block.
+func replayPlasmaBlock(config *params.ChainConfig, statedb *state.StateDB, header *types.Header, txs types.Transactions, cfg vm.Config) (*PlasmaReplayResult, error) {
+	var (
+		expected = *header
+		chain    = &replayChain{config: config, engine: replayEngine(config), header: header}
+		gp       = new(core.GasPool).AddGas(header.GasLimit)
+		usedGas  = new(uint64)
+		receipts types.Receipts
+	)
+	result := &PlasmaReplayResult{
+		PreStateRoot: statedb.IntermediateRoot(config.IsEIP158(header.Number)),
+	}
+	for i, tx := range txs {
+		statedb.Prepare(tx.Hash(), header.Hash(), i)
+		receipt, _, err := core.ApplyTransaction(config, chain, nil, gp, statedb, header, tx, usedGas, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %d (%x): %v", i, tx.Hash(), err)
+		}
+		receipts = append(receipts, receipt)
+	}
+	block, err := chain.engine.Finalize(chain, types.CopyHeader(header), statedb, txs, nil, receipts)
+	if err != nil {
+		return nil, err
+	}
+	result.StateRoot = block.Root()
+	result.TransactionsRoot = block.TxHash()
+	result.ReceiptsRoot = block.ReceiptHash()
+	result.GasUsed = *usedGas
+	result.Receipts = receipts
+
+	if expected.Root != (common.Hash{}) {
+		if result.StateRoot != expected.Root {
+			result.Mismatches = append(result.Mismatches, fmt.Sprintf("stateRoot: block %x", expected.Root))
+		}
+		if result.TransactionsRoot != expected.TxHash {
+			result.Mismatches = append(result.Mismatches, fmt.Sprintf("transactionsRoot: block %x", expected.TxHash))
+		}
+		if result.ReceiptsRoot != expected.ReceiptHash {
+			result.Mismatches = append(result.Mismatches, fmt.Sprintf("receiptsRoot: block %x", expected.ReceiptHash))
+		}
+		if result.GasUsed != expected.GasUsed {
+			result.Mismatches = append(result.Mismatches, fmt.Sprintf("gasUsed: block %d", expected.GasUsed))
+		}
+	}
+	return result, nil
+}
+
+// replayEngine returns the consensus engine finalizing the blocks of the chain.
+func replayEngine(config *params.ChainConfig) consensus.Engine {
+	switch {
+	case config.CliquePlasma != nil:
+		return cliqueplasma.New(config.CliquePlasma)
+	case config.Clique != nil:
+		return clique.New(config.Clique, ethdb.NewMemDatabase())
+	default:
+		return ethash.NewFaker()
+	}
+}
+
+// replayChain is the chain context of a replayed block, which knows no other
+// block than the replayed one.
+type replayChain struct {
+	config *params.ChainConfig
+	engine consensus.Engine
+	header *types.Header
+}
+
+func (c *replayChain) Config() *params.ChainConfig                 { return c.config }
+func (c *replayChain) Engine() consensus.Engine                    { return c.engine }
+func (c *replayChain) CurrentHeader() *types.Header                { return c.header }
+func (c *replayChain) GetHeader(common.Hash, uint64) *types.Header { return nil }
+func (c *replayChain) GetHeaderByNumber(uint64) *types.Header      { return nil }
+func (c *replayChain) GetHeaderByHash(common.Hash) *types.Header   { return nil }
+func (c *replayChain) GetBlock(common.Hash, uint64) *types.Block   { return nil }
+
+// loadStateDump builds a state from a dump made by state.Dump. Storage values
+// are dumped as their RLP encoding in the storage trie.
+func loadStateDump(file string) (*state.StateDB, error) {
+	src, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var dump state.Dump
+	if err := json.Unmarshal(src, &dump); err != nil {
+		return nil, fmt.Errorf("invalid state dump: %v", err)
+	}
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(ethdb.NewMemDatabase()))
+	for addr, account := range dump.Accounts {
+		if !common.IsHexAddress(addr) {
+			return nil, fmt.Errorf("invalid account address %q", addr)
+		}
+		address := common.HexToAddress(addr)
+
+		balance, ok := new(big.Int).SetString(account.Balance, 10)
+		if !ok {
+			return nil, fmt.Errorf("account %s: invalid balance %q", addr, account.Balance)
+		}
+		statedb.SetBalance(address, balance)
+		statedb.SetNonce(address, account.Nonce)
+		statedb.SetCode(address, common.FromHex(account.Code))
+
+		for key, value := range account.Storage {
+			if len(strings.TrimPrefix(key, "0x")) == 0 {
+				return nil, fmt.Errorf("account %s: storage key without preimage", addr)
+			}
+			var content []byte
+			if err := rlp.DecodeBytes(common.FromHex(value), &content); err != nil {
+				return nil, fmt.Errorf("account %s: invalid storage value %q: %v", addr, value, err)
+			}
+			statedb.SetState(address, common.HexToHash(key), common.BytesToHash(content))
+		}
+	}
+	root, err := statedb.Commit(true)
+	if err != nil {
+		return nil, err
+	}
+	if dump.Root != "" && root != common.HexToHash(dump.Root) {
+		log.Warn("Pre-state root mismatch", "dump", dump.Root, "loaded", root)
+	}
+	return statedb, nil
+}
+
+// loadPlasmaBlock decodes a block from the hex encoded RLP in the file.
+func loadPlasmaBlock(file string) (*types.Block, error) {
+	src, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	enc, err := hexutil.Decode(string(bytes.TrimSpace(src)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid block RLP: %v", err)
+	}
+	block := new(types.Block)
+	if err := rlp.DecodeBytes(enc, block); err != nil {
+		return nil, fmt.Errorf("invalid block RLP: %v", err)
+	}
+	return block, nil
+}
+
+// loadRequests decodes the request transactions from the list of hex encoded
+// ERO bytes in the file, as returned by RootChain.getEROBytes.
+func loadRequests(file string) (types.Transactions, error) {
+	src, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var eros []hexutil.Bytes
+	if err := json.Unmarshal(src, &eros); err != nil {
+		return nil, fmt.Errorf("invalid requests: %v", err)
+	}
+	txs := make(types.Transactions, len(eros))
+	for i, ero := range eros {
+		txs[i] = new(types.Transaction)
+		if err := rlp.DecodeBytes(ero, txs[i]); err != nil {
+			return nil, fmt.Errorf("request %d: %v", i, err)
+		}
+	}
+	return txs, nil
+}
@@ -46,9 +46,9 @@ import (
 	"github.com/Onther-Tech/plasma-evm/common"
 	"github.com/Onther-Tech/plasma-evm/core"
 	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/ethclient"
 	"github.com/Onther-Tech/plasma-evm/pls"
 	"github.com/Onther-Tech/plasma-evm/pls/downloader"
-	"github.com/Onther-Tech/plasma-evm/plsclient"
 	"github.com/Onther-Tech/plasma-evm/ethstats"
 	"github.com/Onther-Tech/plasma-evm/les"
 	"github.com/Onther-Tech/plasma-evm/log"
@@ -77,6 +77,9 @@ var (
 	accJSONFlag = flag.String("account.json", "", "Key json file to fund user requests with")
 	accPassFlag = flag.String("account.pass", "", "Decryption password to access faucet funds")
 
+	rootchainURLFlag      = flag.String("rootchain.url", "", "Rootchain endpoint to fund plasma users through enter requests (empty = plain transfers)")
+	rootchainContractFlag = flag.String("rootchain.contract", "", "Address of the RootChain contract to create enter requests on")
+
 	captchaToken  = flag.String("captcha.token", "", "Recaptcha site key to authenticate client side")
 	captchaSecret = flag.String("captcha.secret", "", "Recaptcha secret key to authenticate server side")
 
@@ -131,6 +134,7 @@ func main() {
 		"Periods":   periods,
 		"Recaptcha": *captchaToken,
 		"NoAuth":    *noauthFlag,
+		"Plasma":    *rootchainURLFlag != "",
 	})
 	if err != nil {
 		log.Crit("Failed to render the faucet template", "err", err)
@@ -177,6 +181,15 @@ func main() {
 	}
 	defer faucet.close()
 
+	if *rootchainURLFlag != "" {
+		if !common.IsHexAddress(*rootchainContractFlag) {
+			log.Crit("Invalid RootChain contract address", "address", *rootchainContractFlag)
+		}
+		if err := faucet.enablePlasma(*rootchainURLFlag, common.HexToAddress(*rootchainContractFlag)); err != nil {
+			log.Crit("Failed to connect to rootchain", "url", *rootchainURLFlag, "err", err)
+		}
+	}
+
 	if err := faucet.listenAndServe(*apiPortFlag); err != nil {
 		log.Crit("Failed to launch faucet API", "err", err)
 	}
@@ -188,6 +201,19 @@ type request struct {
 	Account common.Address     `json:"account"` // Ethereum address being funded
 	Time    time.Time          `json:"time"`    // Timestamp when the request was accepted
 	Tx      *types.Transaction `json:"tx"`      // Transaction funding the account
+
+	Status  string   `json:"status,omitempty"`  // Progress of the enter request (plasma only)
+	Request *big.Int `json:"request,omitempty"` // Enter request created on the rootchain (plasma only)
+	ORB     *big.Int `json:"orb,omitempty"`     // Request block applying the enter request (plasma only)
+}
+
+// done returns whether the request is finished and can be dropped, given the
+// current nonce of the faucet.
+func (r *request) done(nonce uint64) bool {
+	if r.Status == "" {
+		return r.Tx.Nonce() < nonce
+	}
+	return r.Status == enterApplied || r.Status == enterFailed
 }
 
 // faucet represents a crypto faucet backed by an Ethereum light client.
@@ -195,6 +221,7 @@ type faucet struct {
 	config *params.ChainConfig // Chain configurations for signing
 	stack  *node.Node          // Ethereum protocol stack
 	client *ethclient.Client   // Client connection to the Ethereum chain
+	plasma *plasma             // RootChain to fund through, nil for plain transfers
 	index  []byte              // Index page to serve up on the web
 
 	keystore *keystore.KeyStore // Keystore containing the single signer
@@ -282,6 +309,7 @@ func newFaucet(genesis *core.Genesis, port int, enodes []*discv5.Node, network u
 
 // close terminates the Ethereum connection and tears down the faucet.
 func (f *faucet) close() error {
+	f.client.Close()
 	return f.stack.Stop()
 }
 
@@ -472,8 +500,28 @@ func (f *faucet) apiHandler(conn *websocket.Conn) {
 			amount = new(big.Int).Mul(amount, new(big.Int).Exp(big.NewInt(5), big.NewInt(int64(msg.Tier)), nil))
 			amount = new(big.Int).Div(amount, new(big.Int).Exp(big.NewInt(2), big.NewInt(int64(msg.Tier)), nil))
 
-			tx := types.NewTransaction(f.nonce+uint64(len(f.reqs)), address, amount, 21000, f.price, nil)
-			signed, err := f.keystore.SignTx(f.account, tx, f.config.ChainID)
+			var (
+				nonce   = f.nonce + uint64(len(f.reqs))
+				chainID = f.config.ChainID
+				tx      *types.Transaction
+				err     error
+			)
+			if f.plasma != nil {
+				// Enter requests are sent to the rootchain, sign them with its chain ID
+				tx, err = f.enterTx(nonce, address, amount)
+				chainID = f.plasma.chainID
+			} else {
+				tx = types.NewTransaction(nonce, address, amount, 21000, f.price, nil)
+			}
+			if err != nil {
+				f.lock.Unlock()
+				if err = sendError(conn, err); err != nil {
+					log.Warn("Failed to send enter request creation error to client", "err", err)
+					return
+				}
+				continue
+			}
+			signed, err := f.keystore.SignTx(f.account, tx, chainID)
 			if err != nil {
 				f.lock.Unlock()
 				if err = sendError(conn, err); err != nil {
@@ -491,12 +539,16 @@ func (f *faucet) apiHandler(conn *websocket.Conn) {
 				}
 				continue
 			}
-			f.reqs = append(f.reqs, &request{
+			req := &request{
 				Avatar:  avatar,
 				Account: address,
 				Time:    time.Now(),
 				Tx:      signed,
-			})
+			}
+			if f.plasma != nil {
+				req.Status = enterPending
+			}
+			f.reqs = append(f.reqs, req)
 			f.timeouts[username] = time.Now().Add(time.Duration(*minutesFlag*int(math.Pow(3, float64(msg.Tier)))) * time.Minute)
 			fund = true
 		}
@@ -550,11 +602,15 @@ func (f *faucet) refresh(head *types.Header) error {
 	if price, err = f.client.SuggestGasPrice(ctx); err != nil {
 		return err
 	}
+	// Advance any enter requests mined on the rootchain
+	if f.plasma != nil {
+		f.trackEnters(ctx, nonce)
+	}
 	// Everything succeeded, update the cached stats and eject old requests
 	f.lock.Lock()
 	f.head, f.balance = head, balance
 	f.price, f.nonce = price, nonce
-	for len(f.reqs) > 0 && f.reqs[0].Tx.Nonce() < f.nonce {
+	for len(f.reqs) > 0 && f.reqs[0].done(f.nonce) {
 		f.reqs = f.reqs[1:]
 	}
 	f.lock.Unlock()
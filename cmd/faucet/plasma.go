@@ -0,0 +1,235 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/Onther-Tech/plasma-evm"
+	"github.com/Onther-Tech/plasma-evm/accounts/abi"
+	"github.com/Onther-Tech/plasma-evm/accounts/abi/bind"
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/ethclient"
+	"github.com/Onther-Tech/plasma-evm/log"
+)
+
+// Progress of an enter request funding a user on the plasma chain.
+const (
+	enterPending = "pending" // Enter request transaction not yet mined on the rootchain
+	enterCreated = "created" // Enter request created, waiting for its request block
+	enterApplied = "applied" // Request block submitted, funds available on the plasma chain
+	enterFailed  = "failed"  // Enter request transaction reverted on the rootchain
+)
+
+// maxORBScan is the maximum number of request blocks to look back through when
+// searching for the one containing an enter request.
+const maxORBScan = 256
+
+// plasma is the rootchain side of a faucet funding users on a plasma chain
+// through enter requests instead of plain transfers.
+type plasma struct {
+	address  common.Address       // Address of the RootChain contract
+	contract *rootchain.RootChain // Binding to the RootChain contract
+	abi      abi.ABI              // Interface of the RootChain contract to pack requests with
+	chainID  *big.Int             // Chain ID of the rootchain to sign enter requests with
+}
+
+// enablePlasma switches the faucet over to funding users through enter requests
+// on the RootChain contract, holding the faucet funds on the rootchain.
+func (f *faucet) enablePlasma(url string, address common.Address) error {
+	client, err := ethclient.Dial(url)
+	if err != nil {
+		return err
+	}
+	code, err := client.CodeAt(context.Background(), address, nil)
+	if err != nil {
+		client.Close()
+		return err
+	}
+	if len(code) == 0 {
+		client.Close()
+		return errors.New("no RootChain contract code at given address")
+	}
+	chainID, err := client.NetworkID(context.Background())
+	if err != nil {
+		client.Close()
+		return err
+	}
+	contract, err := rootchain.NewRootChain(address, client)
+	if err != nil {
+		client.Close()
+		return err
+	}
+	parsed, err := abi.JSON(strings.NewReader(rootchain.RootChainABI))
+	if err != nil {
+		client.Close()
+		return err
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	// The light client is replaced before it's used, release it
+	f.client.Close()
+	f.client = client
+	f.plasma = &plasma{
+		address:  address,
+		contract: contract,
+		abi:      parsed,
+		chainID:  chainID,
+	}
+	log.Info("Funding users through enter requests", "rootchain", url, "contract", address)
+	return nil
+}
+
+// enterTx creates an unsigned ether enter request transaction, crediting amount
+// to the given account on the plasma chain once its request block is applied.
+func (f *faucet) enterTx(nonce uint64, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	data, err := f.plasma.abi.Pack("startEnter", true, to, [32]byte{}, [32]byte{})
+	if err != nil {
+		return nil, err
+	}
+	gas, err := f.client.EstimateGas(context.Background(), ethereum.CallMsg{
+		From:  f.account.Address,
+		To:    &f.plasma.address,
+		Value: amount,
+		Data:  data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return types.NewTransaction(nonce, f.plasma.address, amount, gas, f.price, data), nil
+}
+
+// trackEnters advances the progress of the enter requests mined on the rootchain
+// below the given faucet nonce, notifying the clients of any changes.
+func (f *faucet) trackEnters(ctx context.Context, nonce uint64) {
+	// Only the refresh loop updates the progress, so the fields can be read freely
+	f.lock.RLock()
+	reqs := make([]*request, len(f.reqs))
+	copy(reqs, f.reqs)
+	f.lock.RUnlock()
+
+	opts := &bind.CallOpts{Context: ctx}
+
+	var updated bool
+	for _, req := range reqs {
+		if req.Tx.Nonce() >= nonce {
+			break
+		}
+		status, id, orb := req.Status, req.Request, req.ORB
+
+		// Resolve the request identifier once the enter transaction is mined
+		if status == enterPending {
+			receipt, err := f.client.TransactionReceipt(ctx, req.Tx.Hash())
+			if err != nil {
+				log.Warn("Failed to retrieve enter request receipt", "tx", req.Tx.Hash(), "err", err)
+				break
+			}
+			if receipt.Status == types.ReceiptStatusFailed {
+				log.Warn("Enter request reverted", "tx", req.Tx.Hash(), "account", req.Account)
+				status = enterFailed
+			} else if id, err = f.requestID(ctx, receipt); err != nil {
+				log.Warn("Failed to retrieve enter request", "tx", req.Tx.Hash(), "err", err)
+				break
+			} else {
+				status = enterCreated
+			}
+		}
+		// Find the request block of the request and wait for its submission
+		if status == enterCreated && orb == nil {
+			var err error
+			if orb, err = f.findORB(opts, id); err != nil {
+				log.Warn("Failed to find request block of enter request", "request", id, "err", err)
+			}
+		}
+		if status == enterCreated && orb != nil {
+			block, err := f.plasma.contract.ORBs(opts, orb)
+			if err != nil {
+				log.Warn("Failed to retrieve request block", "orb", orb, "err", err)
+			} else if block.Submitted {
+				log.Info("Enter request applied", "request", id, "orb", orb, "account", req.Account)
+				status = enterApplied
+			}
+		}
+		if status != req.Status || orb != req.ORB {
+			f.lock.Lock()
+			req.Status, req.Request, req.ORB = status, id, orb
+			f.lock.Unlock()
+
+			updated = true
+		}
+	}
+	if updated {
+		select {
+		case f.update <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// requestID extracts the identifier of the enter request created by a mined
+// enter transaction.
+func (f *faucet) requestID(ctx context.Context, receipt *types.Receipt) (*big.Int, error) {
+	if len(receipt.Logs) == 0 {
+		return nil, errors.New("no logs in receipt")
+	}
+	number := receipt.Logs[0].BlockNumber
+
+	it, err := f.plasma.contract.FilterRequestCreated(&bind.FilterOpts{Start: number, End: &number, Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	for it.Next() {
+		if it.Event.Raw.TxHash == receipt.TxHash {
+			return it.Event.RequestId, nil
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	return nil, errors.New("no enter request created")
+}
+
+// findORB searches backwards through the recent request blocks for the one the
+// given enter request was stored in.
+func (f *faucet) findORB(opts *bind.CallOpts, id *big.Int) (*big.Int, error) {
+	num, err := f.plasma.contract.GetNumORBs(opts)
+	if err != nil {
+		return nil, err
+	}
+	request := id.Uint64()
+	for i := num.Int64() - 1; i >= 0 && i >= num.Int64()-maxORBScan; i-- {
+		orb, err := f.plasma.contract.ORBs(opts, big.NewInt(i))
+		if err != nil {
+			return nil, err
+		}
+		if orb.RequestStart <= request && request <= orb.RequestEnd {
+			return big.NewInt(i), nil
+		}
+		if orb.RequestEnd < request {
+			break
+		}
+	}
+	return nil, errors.New("request block not found")
+}
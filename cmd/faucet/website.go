@@ -67,7 +67,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _faucetHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x71\x73\xdb\xb6\x92\xff\xdb\xf9\x14\x5b\x5e\xfc\x24\x9d\x4d\x52\xb6\x93\x3c\x9f\x44\xaa\x93\x97\xd7\xd7\xcb\xcd\x5d\xdb\x69\xd3\xb9\x7b\xd3\xd7\xb9\x01\x89\x95\x88\x18\x04\x58\x00\x94\xac\x7a\xf4\xdd\x6f\x00\x90\x14\x29\xc9\x6e\xd2\xe4\xde\x34\x7f\x38\x24\xb0\xd8\x5d\xec\xfe\x16\xbb\x58\x2a\xf9\xe2\xaf\xdf\xbe\x79\xf7\xf7\xef\xbe\x82\xc2\x94\x7c\xf1\x2c\xb1\xff\x01\x27\x62\x95\x06\x28\x82\xc5\xb3\xb3\xa4\x40\x42\x17\xcf\xce\xce\x92\x12\x0d\x81\xbc\x20\x4a\xa3\x49\x83\xda\x2c\xc3\xdb\x60\x3f\x51\x18\x53\x85\xf8\x4b\xcd\xd6\x69\xf0\x3f\xe1\x8f\xaf\xc3\x37\xb2\xac\x88\x61\x19\xc7\x00\x72\x29\x0c\x0a\x93\x06\x6f\xbf\x4a\x91\xae\xb0\xb7\x4e\x90\x12\xd3\x60\xcd\x70\x53\x49\x65\x7a\xa4\x1b\x46\x4d\x91\x52\x5c\xb3\x1c\x43\xf7\x72\x09\x4c\x30\xc3\x08\x0f\x75\x4e\x38\xa6\x57\xc1\xe2\x99\xe5\x63\x98\xe1\xb8\x78\x78\x88\xbe\x41\xb3\x91\xea\x6e\xb7\x9b\xc1\xeb\xda\x14\x28\x0c\xcb\x89\x41\x0a\x7f\x23\x75\x8e\x26\x89\x3d\xa5\x5b\xc4\x99\xb8\x83\x42\xe1\x32\x0d\xac\xea\x7a\x16\xc7\x39\x15\xef\x75\x94\x73\x59\xd3\x25\x27\x0a\xa3\x5c\x96\x31\x79\x4f\xee\x63\xce\x32\x1d\x9b\x0d\x33\x06\x55\x98\x49\x69\xb4\x51\xa4\x8a\x6f\xa2\x9b\xe8\xcf\x71\xae\x75\xdc\x8d\x45\x25\x13\x51\xae\x75\x00\x0a\x79\x1a\x68\xb3\xe5\xa8\x0b\x44\x13\x40\xbc\xf8\x7d\x72\x97\x52\x98\x90\x6c\x50\xcb\x12\xe3\x17\xd1\x9f\xa3\xa9\x13\xd9\x1f\x7e\x5a\xaa\x15\xab\x73\xc5\x2a\x03\x5a\xe5\x1f\x2c\xf7\xfd\x2f\x35\xaa\x6d\x7c\x13\x5d\x45\x57\xcd\x8b\x93\xf3\x5e\x07\x8b\x24\xf6\x0c\x17\x9f\xc4\x3b\x14\xd2\x6c\xe3\xeb\xe8\x45\x74\x15\x57\x24\xbf\x23\x2b\xa4\xad\x24\x3b\x15\xb5\x83\x9f\x4d\xee\x63\x3e\x7c\x7f\xe8\xc2\xcf\x21\xac\x94\x25\x0a\x13\xbd\xd7\xf1\x75\x74\x75\x1b\x4d\xdb\x81\x63\xfe\x4e\x80\x75\x9a\x15\x75\x16\xad\x51\x59\xe4\xf2\x30\x47\x61\x50\xc1\x83\x1d\x3d\x2b\x99\x08\x0b\x64\xab\xc2\xcc\xe0\x6a\x3a\x3d\x9f\x9f\x1a\x5d\x17\x7e\x98\x32\x5d\x71\xb2\x9d\xc1\x92\xe3\xbd\x1f\x22\x9c\xad\x44\xc8\x0c\x96\x7a\x06\x9e\xb3\x9b\xd8\x39\x99\x95\x92\x2b\x85\x5a\x37\xc2\x2a\xa9\x99\x61\x52\xcc\x2c\xa2\x88\x61\x6b\x3c\x45\xab\x2b\x22\x8e\x16\x90\x4c\x4b\x5e\x1b\x3c\x50\x24\xe3\x32\xbf\xf3\x63\x2e\x9a\xfb\x9b\xc8\x25\x97\x6a\x06\x9b\x82\x35\xcb\xc0\x09\x82\x4a\x61\xc3\x1e\x2a\x42\x29\x13\xab\x19\xbc\xaa\x9a\xfd\x40\x49\xd4\x8a\x89\x19\x4c\xf7\x4b\x92\xb8\x35\x63\x12\xfb\x83\xeb\xd9\x59\x92\x49\xba\x75\x3e\xa4\x6c\x0d\x39\x27\x5a\xa7\xc1\x81\x89\xdd\x81\x34\x20\xb0\xe7\x10\x61\xa2\x9d\x1a\xcc\x29\xb9\x09\xc0\x09\x4a\x03\xaf\x44\x98\x49\x63\x64\x39\x83\x2b\xab\x5e\xb3\xe4\x80\x1f\x0f\xf9\x2a\xbc\xba\x6e\x27\xcf\x92\xe2\xaa\x65\x62\xf0\xde\x84\xce\x3f\x9d\x67\x82\x45\xc2\xda\xb5\x4b\x02\x4b\x12\x66\xc4\x14\x01\x10\xc5\x48\x58\x30\x4a\x51\xa4\x81\x51\x35\x5a\x1c\xb1\x05\xf4\x8f\xbf\x47\x4e\xbf\xe2\xaa\xd5\x2b\xa6\x6c\xdd\x6c\xab\xf7\x78\xb0\xc3\xc7\x37\x71\x0b\xcd\x83\x5c\x2e\x35\x9a\xb0\xb7\xa7\x1e\x31\x13\x55\x6d\xc2\x95\x92\x75\xd5\xcd\x9f\x25\x6e\x14\x18\x4d\x83\x5a\xf1\xa0\x39\xfe\xdd\xa3\xd9\x56\x8d\x29\x82\x6e\xe3\x52\x95\xa1\xf5\x84\x92\x3c\x80\x8a\x93\x1c\x0b\xc9\x29\xaa\x34\xf8\x41\xe6\x8c\x70\x10\x7e\xcf\xf0\xe3\xf7\xff\x09\x8d\xcb\x98\x58\xc1\x56\xd6\x0a\xbe\x32\x05\x2a\xac\x4b\x20\x94\x5a\xb8\x46\x51\xd4\x53\xc4\x61\xf7\x58\xd5\x30\x33\x62\x4f\x75\x96\x64\xb5\x31\xb2\x23\xcc\x8c\x80\xcc\x88\x90\xe2\x92\xd4\xdc\x00\x55\xb2\xa2\x72\x23\x42\x23\x57\x2b\x9b\xe9\xfc\x26\xfc\xa2\x00\x28\x31\xa4\x99\x4a\x83\x96\xb6\xf5\x21\xd1\x95\xac\xea\xaa\xf1\xa2\x1f\xc4\xfb\x8a\x08\x8a\xd4\xfa\x9c\x6b\x0c\x16\x5f\xb3\x35\x42\x89\x7e\x2f\x67\x87\x90\xc8\x89\x42\x13\xf6\x99\x1e\x01\x23\x89\xbd\x32\x7e\x4b\xd0\xfc\x4b\x6a\xde\x72\xea\xb6\x50\xa2\xa8\x61\xf0\x16\x2a\x7b\xae\x04\x8b\x87\x07\x45\xc4\x0a\xe1\x39\xa3\xf7\x97\xf0\x9c\x94\xb2\x16\x06\x66\x29\x44\xaf\xdd\xa3\xde\xed\x06\xdc\x01\x12\xce\x16\x09\x79\x0a\xde\x20\x45\xce\x59\x7e\x97\x06\x86\xa1\x4a\x1f\x1e\x2c\xf3\xdd\x6e\x0e\x0f\x0f\x6c\x09\xcf\xa3\xef\x31\x27\x95\xc9\x0b\xb2\xdb\xad\x54\xfb\x1c\xe1\x3d\xe6\xb5\xc1\xf1\xe4\xe1\x01\xb9\xc6\xdd\x4e\xd7\x59\xc9\xcc\xb8\x5d\x6e\xc7\x05\xdd\xed\xac\xce\x8d\x9e\xbb\x1d\xc4\x96\xa9\xa0\x78\x0f\xcf\xa3\xef\x50\x31\x49\x35\x78\xfa\x24\x26\x8b\x24\xe6\x6c\xd1\xac\x1b\x1a\x29\xae\xf9\x1e\x2f\xb1\x05\x4c\x87\x73\x17\x36\x4e\xd5\xbe\xa6\x27\xa2\x60\x15\x76\xda\x37\x78\xd0\xcc\xe0\x1d\x6e\xd3\xe0\xe1\xa1\xbf\xb6\x99\xcd\x09\xe7\x19\xb1\x76\xf1\x5b\xeb\x16\xfd\x8a\x16\xa7\x6b\xa6\x5d\x49\xb5\x68\x35\xd8\xab\xfd\x81\x61\x7d\x70\x70\x19\x59\xcd\xe0\xe6\xba\x77\x6a\x9d\x8a\xf8\x57\x07\x11\x7f\x73\x92\xb8\x22\x02\x39\xb8\xbf\xa1\x2e\x09\x6f\x9f\x9b\x68\xe9\x05\xdf\xe1\xa2\xd0\x9e\xd1\x9d\x6a\xdd\x59\x3f\x9d\x83\x5c\xa3\x5a\x72\xb9\x99\x01\xa9\x8d\x9c\x43\x49\xee\xbb\x7c\x77\x33\x9d\xf6\xf5\xb6\xa5\x20\xc9\x38\xba\xd3\x45\xe1\x2f\x35\x6a\xa3\xbb\xb3\xc4\x4f\xb9\xbf\xf6\x48\xa1\x28\x34\xd2\x03\x6b\x58\x89\xd6\xb4\x8e\xaa\xe7\xfa\xce\x98\x27\x75\x5f\x4a\xd9\xa5\x90\xbe\x1a\x0d\xeb\x5e\xb6\x0b\x16\x89\x51\x7b\xba\xb3\xc4\xd0\x8f\x4a\x01\xca\x96\x78\x8f\x65\x00\x7f\xa2\xd9\xbd\x57\x88\xca\xd7\x17\x16\xb2\xe0\x5e\x93\xd8\xd0\x4f\x90\x6c\x41\x98\x11\x8d\x1f\x22\xde\x65\xfa\xbd\x78\xf7\xfa\xa9\xf2\x0b\x24\xca\x64\x48\xcc\x87\x28\xb0\xac\x05\xed\xed\xdf\x9d\x9d\x9f\xaa\x40\x2d\xd8\x1a\x95\x66\x66\xfb\xa1\x1a\x20\xdd\xab\xe0\xdf\x87\x2a\x24\xb1\x51\x4f\x63\xad\xff\xf2\x99\x82\xfb\xb7\x4a\x92\x9b\xc5\xbf\xcb\x0d\x50\x89\x1a\x4c\xc1\x34\xd8\xe4\xfa\x65\x12\x17\x37\x1d\x49\xb5\x78\x67\x27\x9c\x51\x61\xe9\x4a\x0b\x60\x1a\x54\x2d\x5c\xe6\x95\x02\x4c\x81\xc3\x72\xa4\x49\xd2\x11\xbc\x93\xb6\xa4\x5b\xa3\x30\x50\x12\xce\x72\x26\x6b\x0d\x24\x37\x52\x69\x58\x2a\x59\x02\xde\x17\xa4\xd6\xc6\x32\xb2\xc7\x07\x59\x13\xc6\x5d\x2c\x39\x97\x82\x54\x40\xf2\xbc\x2e\x6b\x5b\x92\x8a\x15\xa0\x90\xf5\xaa\x68\x74\x31\x12\x7c\x62\xe2\x52\xac\x3a\x7d\x74\x45\x4a\x20\xc6\x90\xfc\x4e\x5f\x42\x7b\x2a\x00\x51\x08\x86\x21\xb5\xab\x72\x59\x96\x52\xc0\x8d\xa2\x50\x11\x65\xb6\xa0\x87\xb5\x05\xc9\x73\x97\xe5\x22\x78\x2d\xb6\x52\x20\x14\x64\xed\x34\x84\x77\xfe\x3a\x71\x09\x5f\x4b\xb9\xe2\x78\x61\x15\xfc\x1b\xc9\x31\x93\xb2\x5b\x06\x25\xd9\xb6\x72\x9b\x6d\x6c\x98\x29\x98\xb7\x53\x85\xaa\xb4\x3c\x28\x70\x56\x32\xa3\xa3\x24\xae\xf6\x47\xeb\x3e\x49\xf3\xb0\x90\x8a\xfd\x6a\x2b\x1c\xde\x3f\x47\xcd\xc1\x29\xd3\x1e\x92\xce\xfd\x1c\x97\x66\x06\x2f\xfc\x21\x79\x08\xe8\xe6\x2a\x74\x0a\xcd\x2d\x4f\x77\xc5\xb4\x99\x67\x06\x37\xbe\xae\xf5\x15\x05\x35\x3d\x0d\xe8\x01\xe6\xbc\xd0\xdb\xdb\xea\xbe\xd3\xa3\x2b\x8e\xa7\x1d\x13\x0b\x85\xa1\x51\xd6\xac\x67\xcf\x92\xdc\x21\x10\x48\xc8\xc1\x55\xb9\x51\xda\x5d\xb4\x98\x6b\x14\xc4\x66\x83\x68\xbe\xb4\x31\x9c\x7e\xef\x19\x32\xb1\x3a\xbf\x9e\x7a\x68\xda\x07\xcb\xfe\xfc\x7a\xca\x84\x91\xe7\xd7\xd3\xe9\xfd\xf4\x03\xff\x9d\x5f\x4f\xa5\x38\xbf\x9e\x9a\x02\xcf\xaf\xa7\xe7\xd7\x37\x7d\x50\xfb\x91\xb6\xc4\xb4\x54\xa8\xad\xb4\x16\xeb\x01\x18\xa2\x56\x68\xd2\xe0\x7f\x49\x26\x6b\x33\xcb\x38\x11\x77\xc1\xc2\xa9\x6b\xcb\x0e\x87\x82\xd3\x85\x2a\x54\x44\x5b\x48\x58\x8d\x1d\x4a\x9a\xa6\x88\x86\xb1\xae\x95\x92\xb5\xb0\xe9\x11\xec\x9e\x5d\xa8\x8a\x91\x45\x99\x35\xcc\x24\x4a\x32\x15\x2f\xde\xc8\x6a\x1b\x3a\x26\x6e\xf9\x91\x19\x75\x5d\x55\x52\x99\xa8\x6f\x4e\x62\x2f\x44\x1c\x75\x7c\x3b\x7d\x79\xfb\xea\x49\xf5\xb5\x2d\xb7\xdd\x1e\x3a\x0d\x49\x26\xd7\x08\xbe\xb8\xcf\xe4\x3d\x10\x41\x61\xc9\x14\x02\xd9\x90\xed\x17\x49\x4c\xdd\x55\xec\xd3\x51\xbb\x72\x81\x16\x56\xbc\xd6\xb6\x16\x61\x36\x50\xff\x50\x10\xf6\x27\x01\x7c\xc7\x6b\x7d\x09\x55\x9d\x71\xa6\x0b\x20\x20\x70\x03\x89\x36\x4a\x8a\xd5\xc2\x8d\xe6\xf6\xaa\xea\x5e\xa1\x92\xda\x3c\x85\x06\x2c\x33\xa4\xf4\x04\x1e\x7e\x27\x1c\xac\x3c\xe7\xc2\x7f\xbe\xfb\x96\xcd\xe1\xf8\x87\x72\x59\x7b\x62\xff\x51\xfd\x75\x14\xbe\x9b\xcd\x26\x6a\x2d\xe9\x62\xb7\x40\x5e\xc5\x36\x8d\xd5\x82\x99\x6d\xec\x4f\x41\x29\xe2\x2f\x19\x4d\xaf\x6f\xaf\x5f\xbd\xba\x7e\xf1\x6f\xb7\x2f\x5f\x5e\xdf\xbe\x78\xf9\x58\x60\x77\xa0\xf8\xfd\x71\xed\xaf\x43\xdf\xc8\xd7\xb5\x29\xba\xbb\x90\xc7\x4b\x5b\x83\xdb\x4a\x8b\xda\xbb\xa4\x0a\x7e\x37\x86\x6a\x61\x0b\xca\x90\xf0\x93\xb5\xe0\x47\xa0\xc8\xc1\xe8\x09\xcd\x3e\x11\x5a\x2d\x7c\x2c\x52\x64\x6d\xec\x0e\xdb\xa6\x0c\x93\xa2\x83\xd3\x25\x68\x56\x56\x7c\x0b\xf9\xde\xeb\xa7\x71\xf5\xa8\x53\x7e\x13\x56\x43\xb7\x79\x90\xb9\x2a\xae\x94\x14\x6d\xf5\xa6\x6b\x9d\x63\xe5\xba\xf5\xb6\x22\xfa\xcb\xf6\x57\x22\x0c\x13\xd8\x56\x4e\x11\x7c\x2b\xf8\x16\x6a\x8d\xb0\x94\x0a\x28\x66\xf5\x6a\xe5\xca\x3d\x05\x95\x62\x6b\x62\xb0\x2d\x97\x74\x83\x8a\x0e\x14\xbd\x1b\xaa\x2d\x5d\x79\xaf\x92\xfc\xbb\xac\x21\x27\x02\x8c\x22\xf9\x9d\x8f\x94\x5a\x29\x1b\x29\x15\xfa\xdd\x74\x05\x5b\x86\x5c\x6e\x1c\x89\xdf\xf7\x92\x21\x77\xd5\x9b\x46\x84\x42\x6e\xa0\xac\x73\x17\x90\xb6\x3a\x73\x9b\xd8\x10\x66\xa0\x16\x86\x71\x6f\x4f\x53\x2b\x61\x6b\x3d\x1c\x14\x59\x47\x77\xf8\x04\xcb\xc5\xbb\x02\x4f\x94\xb6\xdd\xed\x1b\x14\xbe\xf1\xe4\x50\x29\x69\x30\xb7\x0e\x05\xb2\x22\x4c\x68\xeb\x11\x57\xc6\x61\xf9\x01\xb7\xf3\xee\xa9\x79\xd8\x77\x9a\xdd\x74\x1c\xc3\xd7\x5c\x66\x84\xc3\xda\x22\x3d\xe3\xb6\x2c\x97\x50\x48\xbb\xf5\x9e\xb5\xb4\x21\xa6\xd6\x20\x97\x6e\xd4\x6b\x6e\xd7\xaf\x89\xb2\x1e\xc4\xb2\x32\x90\x36\x7d\x52\x3b\xa6\x51\xad\x9b\xee\xaf\x7d\x35\x0c\xd5\x60\xbe\xb3\x7a\x0a\x3f\xfd\x3c\x7f\xd6\xa8\xf2\x57\x5c\x3a\x48\x58\x7c\xfb\x2d\x9b\x82\x18\xc8\x15\x12\x83\x1a\x72\x2e\x75\xad\xbc\x86\x54\xc9\x0a\xac\x96\x2d\xa7\x96\xb3\x9d\xa8\x9c\xb4\x96\xc9\xb8\x20\xba\x98\x34\x6d\x5e\x85\xce\x4b\xdd\x5c\x3b\x7e\x66\x51\x37\xb6\x0c\x58\x3a\x9d\x03\x4b\x5a\xbe\x11\x47\xb1\x32\xc5\x1c\xd8\xc5\x45\x47\x7c\xc6\x96\x30\x6e\x29\x7e\x62\x3f\x47\xe6\x3e\xb2\x52\x20\x4d\xa1\x2f\xcd\x09\x6c\xf8\xe8\x8a\xb3\x1c\xc7\xec\x12\xae\x26\xf3\x76\x36\x53\x48\xee\xda\xb7\xc6\x8f\xfe\x3f\xf7\x77\x37\x1f\x5a\xc6\x19\x7f\x60\x1b\xdf\xc3\xd1\x40\x60\xc5\xb4\x81\x5a\x71\x68\x62\xd8\xbb\xa0\x73\x88\xa3\xeb\x5b\xe5\x08\x97\xcd\x43\x83\xa9\x76\x0b\x9e\x4d\xa4\x51\xd0\xf1\x7f\xfc\xf0\xed\x37\x91\x36\x8a\x89\x15\x5b\x6e\xc7\x0f\xb5\xe2\x33\x78\x3e\x0e\xfe\xa5\x56\x3c\x98\xfc\x34\xfd\x39\x5a\x13\x5e\xe3\xa5\xf3\xf7\xcc\xfd\x3d\x92\x72\x09\xcd\xe3\x0c\x86\x02\x77\x93\xc9\xfc\x74\xbf\xab\xd7\x9e\x53\xa8\xd1\x8c\x2d\x61\x07\xfc\x43\x1b\x11\x28\xd1\x14\xd2\x85\xae\xc2\x5c\x0a\x81\xb9\x81\xba\x92\xa2\x31\x09\x70\xa9\xf5\x1e\x88\x2d\x45\x7a\x0c\x8a\x86\x3e\x75\xc9\xfa\xbf\x31\xfb\x41\xe6\x77\x68\xc6\xe3\xf1\x86\x09\x2a\x37\x11\x97\xfe\xa8\x8d\x6c\x90\xca\x5c\x72\x48\xd3\x14\x9a\x2c\x1a\x4c\xe0\x4b\x08\x36\xda\xe6\xd3\x00\x66\xf6\xd1\x3e\x4d\xe0\x02\x0e\x97\x17\x36\xdf\x5f\x40\x10\x93\x8a\x05\x13\x1f\x0e\xad\xe1\xa5\x28\x51\x6b\xb2\xc2\xbe\x82\xee\x86\xdb\x81\xcc\xee\xa3\xd4\x2b\x48\xc1\x39\xa8\x22\x4a\xa3\x27\x89\x28\x31\xa4\x45\x9b\xc5\xac\x23\x4b\x53\x10\x35\xe7\x7b\x90\xfa\xa0\x98\xb7\xf0\x1b\x90\x47\x3e\xd7\x7c\x91\xa6\x50\x0b\xea\x4c\x4c\xf7\x2b\xad\xf3\x7d\x33\x64\x12\xd9\xbc\xb0\x5f\x31\x99\xf7\xd1\x3c\xe0\x86\xf4\xb7\xd8\x21\x3d\xe4\x87\xf4\x11\x86\xae\xf7\xf4\x14\x3f\xdf\xab\xea\xb1\x73\x03\x8f\x70\x13\x75\x99\xa1\x7a\x8a\x9d\xef\x3d\x35\xec\x9c\xa9\xdf\x0a\xd3\x5b\x7b\x09\x57\xaf\x26\x8f\x70\x47\xa5\xe4\xa3\xcc\x85\x34\xdb\xf1\x03\x27\x5b\x5b\x33\xc1\xc8\xc8\xea\x8d\x6b\x15\x8d\x2e\x5d\xc6\x9d\x41\xc7\xe1\xd2\x7d\x04\x98\xc1\xc8\xbd\xd9\x79\x56\xa2\x5b\xf5\x72\x3a\x9d\x5e\x42\xfb\xf5\xec\x2f\xc4\x06\xa1\xaa\x71\xf7\x88\x3e\xba\xce\x73\x9b\xf7\x3f\x45\xa3\x86\x47\xa7\x53\xf3\xfe\x09\x5a\x75\xb9\x61\xa0\x16\xfc\xe9\x4f\x70\x34\x3b\x84\x71\x1c\xc3\x7f\x11\x75\xe7\x1a\x3b\x95\xc2\xb5\x6b\xfe\x74\xf4\x25\xd3\xda\x35\x55\x34\x50\x29\xb0\x59\xf3\x71\xc7\xfe\x91\x8e\x0d\x19\x2c\x60\x7a\xa8\xa0\x3d\x0e\x7b\x69\xe1\x44\xb6\xe8\xf1\x1d\x26\x82\xb3\x5d\x5f\xde\x60\x25\x2b\x11\xbe\x48\x21\x08\xfa\x8b\x8f\x28\x2c\x41\xc7\xec\x4c\xa3\x79\xe7\x7d\x31\x6e\xb2\xe3\xa9\xdc\x35\xb9\x84\x9b\xe9\x74\x3a\x39\x52\x62\xb7\x37\xef\xeb\xca\x96\x4d\x40\xc4\xd6\x1d\x89\x9d\x6d\x5d\xe1\x68\x4b\x20\x7b\xa4\x71\xc8\x25\xe7\xbe\x66\x69\x96\x5a\x03\x37\x4d\xb0\x14\xc2\xab\xf9\x89\x2c\xda\xb3\x64\x6f\x6b\x87\xee\x39\x61\xfb\x43\x17\x0d\x6d\x76\x40\x1c\x5e\x0d\x9c\x32\xf0\xd7\x69\xc7\x9c\x75\x7a\xb3\xbd\x45\x0f\xdc\xb5\xf7\xd7\xa1\xcd\x7a\xfa\x7b\x3e\x17\x57\x1f\xb8\x8d\x6e\xba\xaa\x75\x31\x3e\x50\x74\x32\x3f\xf6\xcd\x5b\x83\xca\x56\xc9\xd2\xa6\x2c\xeb\x0b\x7b\x15\x50\x78\xe4\x12\x57\xaa\x2b\x0c\x15\x0a\x8a\xaa\x2d\x29\x7c\x65\x6f\x0b\xc0\x81\xcb\xfc\xad\xb2\x0f\xa7\x8f\x0c\x18\x57\x92\x49\x81\x00\x00\x07\x41\xe0\x80\x3a\x40\xaa\x25\x46\x4e\x2a\x8d\x14\x52\xf0\x3f\x66\x18\x4f\xa2\x5a\xb0\xfb\xf1\x24\x6c\xde\x0f\x79\xb4\xf3\xf3\xee\x9a\xd8\xaa\x7d\x91\x42\x90\x18\x05\x8c\xa6\xa3\x00\x2e\x4e\x85\xa0\xcd\xba\xa3\xc5\x5e\x83\xfe\x52\x80\xc4\xd0\x85\xeb\x67\xfb\xfb\xda\x3f\x82\x8c\xe4\x77\x2b\x77\x11\x9a\xd9\x52\x6b\x7c\xc4\x96\xac\x89\x21\xca\x71\x9d\xcc\x61\x4f\xde\x5c\x14\x73\xeb\x9c\x39\xf8\x1b\xa9\x6b\x9b\x43\xf7\xa9\xc9\xbd\x65\x52\x51\x54\xa1\x22\x94\xd5\x7a\x06\x2f\xaa\xfb\xf9\x3f\xda\x4f\x71\xae\xb9\xff\xa4\xaa\x95\xc2\xc5\x91\x46\x4d\x93\xf8\x02\x82\x24\xb6\x04\xbf\xc5\xa6\xdb\x6c\xff\x47\x14\x70\xe2\x13\x06\x74\x3f\x71\x68\xc6\x4b\x46\x29\x47\xab\xf0\x9e\xbd\x0d\x46\xeb\xff\x7e\x48\x0d\x45\x42\xf3\xed\x62\xbf\x66\x07\xc8\x35\x3e\xb1\xa0\xfb\x0c\x32\xb2\x00\x08\xed\x96\x99\xb3\x79\x73\xd9\x76\xc3\x6a\xe4\x6c\xd1\xfc\x24\x86\xd6\xca\xd5\x5a\xe3\xb0\x01\xd8\x25\x8c\xb4\xad\xfd\xa8\x1e\x4d\xa2\xa2\x2e\x89\x60\xbf\xe2\xd8\xe6\xa5\x89\xb7\x95\xfb\xae\x12\x1c\x1f\xc9\x47\xca\xec\x3f\x78\x8c\xda\x1c\x37\x6a\x8c\x38\x6a\xbd\xfb\x62\x7f\xb7\x9f\xc1\x74\x3e\xfa\x48\x0b\x9d\x96\x12\x66\x44\x41\xff\x25\x6c\x93\x2f\x28\x69\xa5\xb7\x73\x19\x51\x23\xdf\xc9\x70\xf5\xb9\x90\x9b\x74\x74\x33\xed\x94\xf4\x8e\x76\x7e\x1e\x35\x58\x3b\x72\x86\xd5\xb2\x0d\xcd\x05\xdc\x4c\x3f\x87\xb6\xbe\x1b\x72\xb0\x03\xa3\x58\x85\x14\x48\x6e\xd8\x1a\xff\x1f\x36\xf2\x19\x8c\xfc\xd1\x2a\x5a\x1c\xb6\xc6\x73\x30\x1d\xe8\x6b\x67\x3b\xdb\xfe\xab\x8d\x37\x88\x9d\x85\x2f\x20\x38\xb9\x91\x47\x91\x78\x40\x78\x10\xda\x8f\xc7\xbd\xfb\x50\x18\x1c\xe6\x14\x5b\xed\x76\x1f\xb9\x27\x51\x61\x4a\x3e\x0e\x12\xe3\x7e\xec\x64\x75\xee\x38\x38\x06\x7e\x78\x58\xd2\xed\x86\x17\x19\x7b\x7f\xc7\x83\x7b\x16\xf4\x8a\x93\xee\x2e\xd6\x56\x22\xb0\xdb\xff\x26\x2c\x8e\xe1\x07\x43\x94\x01\x02\x3f\xbe\x85\xba\xa2\xc4\xf8\x4f\x72\x36\x3f\xfa\xae\x73\xfb\xa3\xb1\x8c\x28\x0d\x4b\xa9\x36\x44\xd1\xa6\x3f\x63\x0a\xdc\xba\x4f\x72\x6d\xe9\xa7\xd1\xbc\xb5\xa7\xd8\x9a\xf0\xf1\xd1\xbd\xef\xf9\x78\x14\xf5\x5d\x3e\x9a\x44\x48\xf2\xe2\x98\xd0\x65\xac\x4e\x6e\x0a\xdf\xb8\x2b\xc0\xf8\xf9\xd8\x14\x4c\x4f\x22\x62\x8c\x1a\x8f\x06\x60\x18\x4d\xac\x5f\xaf\x7a\x57\xb2\x6e\x79\x32\x08\xab\xa7\x78\xec\x8b\xe9\xae\x10\x68\xc9\x73\xad\xc7\x1e\x57\xa3\xcb\x1e\xef\x21\xac\x46\xe7\xa3\xce\x51\xfb\xf0\xde\xef\x23\x3d\xa9\xc9\x80\xf5\xc8\x46\xd9\xe8\x48\x3c\xa1\xf4\x8d\x8d\x9f\x71\x70\x22\xd2\x0f\xd1\x31\xe9\x8c\xed\xcf\xeb\x27\xad\xec\x7f\x5e\xf3\x88\x89\x19\x1d\x4d\x22\x5d\x67\xbe\x37\x31\x7e\xd9\x5d\xc0\x5a\x32\x07\xde\xc3\x54\x70\x54\x50\x58\x11\xc3\xa2\x22\x3c\x28\x42\x9e\xc8\x1a\x8d\x48\xbf\xab\xdd\xa5\x35\xf8\x74\xd2\xb5\xb6\xbe\xd2\xb6\xb8\xf2\xad\xff\x0d\x66\xda\x75\x12\xa0\xc1\xbb\xeb\xe6\xf8\xae\xcd\xeb\xef\xde\xf6\x3a\x37\x5d\x44\x8c\x1d\xf7\xee\xf7\x9c\xa7\xfa\x24\x27\x7f\x40\xba\xd9\x6c\x22\xff\x45\xcb\xb5\xf1\xbb\x46\x4a\x4c\x2a\x16\xbd\xd7\x01\x10\xbd\x15\x39\x50\x5c\xa2\x5a\xf4\xd8\x37\xdd\x95\x24\xf6\x3f\x6d\x4c\x62\xff\xeb\xed\xff\x0b\x00\x00\xff\xff\x56\xf8\xb5\xef\xce\x2d\x00\x00")
+var _faucetHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\xef\x72\xe3\x36\x92\xff\x2c\x3f\x45\x87\x3b\xb3\x92\xce\x26\x29\xdb\x33\xb3\x3e\x89\x54\x6a\x76\x36\x9b\x9b\xab\xbb\x24\x95\x4c\xea\x6e\x2b\x49\x5d\x41\x64\x4b\x84\x0d\x02\x0c\x00\x4a\x56\x5c\x7a\xf7\xab\x06\xff\x88\xa2\x64\xc7\x99\xc9\xed\xc5\x1f\x64\x12\x68\x34\x1a\xdd\xbf\x6e\x34\x1a\x8c\x3e\xfb\xdb\xd7\xef\x3e\xfc\xe3\x9b\x2f\x20\xb3\xb9\x98\x9f\x45\xf4\x0f\x04\x93\xab\xd8\x43\xe9\xcd\xcf\x06\x51\x86\x2c\x9d\x9f\x0d\x06\x51\x8e\x96\x41\x92\x31\x6d\xd0\xc6\x5e\x69\x97\xfe\x8d\xb7\xef\xc8\xac\x2d\x7c\xfc\xb9\xe4\xeb\xd8\xfb\x6f\xff\xfb\xb7\xfe\x3b\x95\x17\xcc\xf2\x85\x40\x0f\x12\x25\x2d\x4a\x1b\x7b\xef\xbf\x88\x31\x5d\x61\x67\x9c\x64\x39\xc6\xde\x9a\xe3\xa6\x50\xda\x76\x48\x37\x3c\xb5\x59\x9c\xe2\x9a\x27\xe8\xbb\x97\x0b\xe0\x92\x5b\xce\x84\x6f\x12\x26\x30\xbe\xf4\xe6\x67\xc4\xc7\x72\x2b\x70\xfe\xf0\x10\x7c\x85\x76\xa3\xf4\xdd\x6e\x37\x85\xb7\xa5\xcd\x50\x5a\x9e\x30\x8b\x29\xfc\x9d\x95\x09\xda\x28\xac\x28\xdd\x20\xc1\xe5\x1d\x64\x1a\x97\xb1\x47\xa2\x9b\x69\x18\x26\xa9\xbc\x35\x41\x22\x54\x99\x2e\x05\xd3\x18\x24\x2a\x0f\xd9\x2d\xbb\x0f\x05\x5f\x98\xd0\x6e\xb8\xb5\xa8\xfd\x85\x52\xd6\x58\xcd\x8a\xf0\x3a\xb8\x0e\xfe\x12\x26\xc6\x84\x6d\x5b\x90\x73\x19\x24\xc6\x78\xa0\x51\xc4\x9e\xb1\x5b\x81\x26\x43\xb4\x1e\x84\xf3\x8f\x9b\x77\xa9\xa4\xf5\xd9\x06\x8d\xca\x31\x7c\x15\xfc\x25\x98\xb8\x29\xbb\xcd\x4f\xcf\x4a\xd3\x9a\x44\xf3\xc2\x82\xd1\xc9\xb3\xe7\xbd\xfd\xb9\x44\xbd\x0d\xaf\x83\xcb\xe0\xb2\x7e\x71\xf3\xdc\x1a\x6f\x1e\x85\x15\xc3\xf9\x27\xf1\xf6\xa5\xb2\xdb\xf0\x2a\x78\x15\x5c\x86\x05\x4b\xee\xd8\x0a\xd3\xba\x2b\xa0\xae\xa0\x69\xfc\xdd\xe6\x7d\xcc\x86\xb7\x7d\x13\xfe\x1e\x93\xe5\x2a\x47\x69\x83\x5b\x13\x5e\x05\x97\x37\xc1\xa4\x69\x38\xe6\xef\x56\x43\x46\xa3\xa9\x06\xc1\x1a\x35\x21\x57\xf8\x09\x4a\x8b\x1a\x1e\xa8\x75\x90\x73\xe9\x67\xc8\x57\x99\x9d\xc2\xe5\x64\xf2\x72\x76\xaa\x75\x9d\x55\xcd\x29\x37\x85\x60\xdb\x29\x2c\x05\xde\x57\x4d\x4c\xf0\x95\xf4\xb9\xc5\xdc\x4c\xa1\xe2\xec\x3a\x76\xf4\x13\x14\x5a\xad\x34\x1a\x53\x4f\x56\x28\xc3\x2d\x57\x72\x4a\x38\x66\x96\xaf\xf1\x14\xad\x29\x98\x3c\x1a\xc0\x16\x46\x89\xd2\x62\x4f\x90\x85\x50\xc9\x5d\xd5\xe6\xbc\xb9\xbb\x88\x44\x09\xa5\xa7\xb0\xc9\x78\x3d\x0c\x9c\x50\x50\x68\xac\xd9\x43\xc1\xd2\x94\xcb\xd5\x14\xde\x14\xf5\x7a\x20\x67\x7a\xc5\xe5\x14\x26\xfb\x21\x51\xd8\xa8\x31\x0a\xab\xc0\x75\x36\x88\x16\x2a\xdd\x92\x62\xa3\x94\xaf\x21\x11\xcc\x98\xd8\xeb\xa9\xd8\x05\xa4\x03\x02\x8a\x43\x8c\xcb\xa6\xeb\xa0\x4f\xab\x8d\x07\x6e\xa2\xd8\xab\x84\xf0\x17\xca\x5a\x95\x4f\xe1\x92\xc4\xab\x87\xf4\xf8\x09\x5f\xac\xfc\xcb\xab\xa6\x73\x10\x65\x97\x0d\x13\x8b\xf7\xd6\x77\xf6\x69\x2d\xe3\xcd\x23\xde\x8c\x5d\x32\x58\x32\x7f\xc1\x6c\xe6\x01\xd3\x9c\xf9\x19\x4f\x53\x94\xb1\x67\x75\x89\x84\x23\x3e\x87\x6e\xf8\x7b\x24\xfa\x65\x97\x8d\x5c\x61\xca\xd7\xf3\xb3\xfe\x63\x6f\x85\x8f\x2f\xe2\x06\xea\x07\xb5\x5c\x1a\xb4\x7e\x67\x4d\x1d\x62\x2e\x8b\xd2\xfa\x2b\xad\xca\xa2\xed\x1f\x44\xae\x15\x78\x1a\x7b\xa5\x16\x5e\x1d\xfe\xdd\xa3\xdd\x16\xb5\x2a\xbc\x86\xc5\x52\xe9\xdc\x27\x4b\x68\x25\x3c\x28\x04\x4b\x30\x53\x22\x45\x1d\x7b\xdf\xa9\x84\x33\x01\xb2\x5a\x33\x7c\xff\xed\x7f\x40\x6d\x32\x2e\x57\xb0\x55\xa5\x86\x2f\x6c\x86\x1a\xcb\x1c\x58\x9a\x12\xb4\x83\x20\xe8\x08\xe2\xb0\x7b\x2c\xaa\xbf\xb0\x72\x4f\x35\x88\x16\xa5\xb5\xaa\x25\x5c\x58\x09\x0b\x2b\xfd\x14\x97\xac\x14\x16\x52\xad\x8a\x54\x6d\xa4\x6f\xd5\x6a\x45\x3b\x5d\xb5\x88\x6a\x90\x07\x29\xb3\xac\xee\x8a\xbd\x86\xb6\xb1\x21\x33\x85\x2a\xca\xa2\xb6\x62\xd5\x88\xf7\x05\x93\x29\xa6\x64\x73\x61\xd0\x9b\x7f\xc9\xd7\x08\x39\x56\x6b\x19\xf4\x21\x91\x30\x8d\xd6\xef\x32\x3d\x02\x46\x14\x56\xc2\x54\x4b\x82\xfa\x2f\x2a\x45\xc3\xa9\x5d\x42\x8e\xb2\xdc\x2f\x88\xde\x7c\x4d\xd1\xc6\x9b\x3f\x3c\x68\x26\x57\x08\x2f\x78\x7a\x7f\x01\x2f\x58\xae\x4a\x69\x61\x1a\x43\xf0\xd6\x3d\x9a\xdd\xee\x80\x3b\x40\x24\xf8\x3c\x62\x4f\xc1\x1b\x94\x4c\x04\x4f\xee\x62\xcf\x72\xd4\xf1\xc3\x03\x31\xdf\xed\x66\xf0\xf0\xc0\x97\xf0\x22\xf8\x16\x13\x56\xd8\x24\x63\xbb\xdd\x4a\x37\xcf\x01\xde\x63\x52\x5a\x1c\x8d\x1f\x1e\x50\x18\xdc\xed\x4c\xb9\xc8\xb9\x1d\x35\xc3\xa9\x5d\xa6\xbb\x1d\xc9\x5c\xcb\xb9\xdb\x41\x48\x4c\x65\x8a\xf7\xf0\x22\xf8\x06\x35\x57\xa9\x71\x6b\xd9\xed\xa2\x90\xcd\xa3\x50\xf0\x79\x3d\xee\x50\x49\x61\x29\xf6\x78\x09\x09\x30\xcd\x6b\xe5\x36\x4e\xd4\xae\xa4\x27\xbc\x60\xe5\xb7\xd2\xd7\x78\x30\xdc\xe2\x1d\x6e\x63\xef\xe1\xa1\x3b\xb6\xee\x4d\x98\x10\x0b\x46\x7a\xa9\x96\xd6\x0e\xfa\x05\x63\x8f\xcb\x35\x37\x2e\xa5\x9a\x37\x12\xec\xc5\x7e\xa6\x5b\xf7\x02\x97\x55\xc5\x14\xae\xaf\x3a\x51\xeb\x94\xc7\xbf\xe9\x79\xfc\xf5\x49\xe2\x82\x49\x14\xe0\x7e\x7d\x93\x33\xd1\x3c\xd7\xde\xd2\x8e\x39\x1e\xe4\x53\x8c\x6e\x63\x6a\x1b\xeb\x27\x33\x50\x6b\xd4\x4b\xa1\x36\x53\x60\xa5\x55\x33\xc8\xd9\x7d\xbb\xdf\x5d\x4f\x26\x5d\xb9\x29\x15\x64\x0b\x81\x2e\xba\x68\xfc\xb9\x44\x63\x4d\x1b\x4b\xaa\x2e\xf7\x4b\x21\x25\x45\x69\x30\xed\x85\x71\xda\x4b\x28\x9e\x3a\xaa\xbd\xb4\x7b\x65\x9e\x94\x7d\xa9\x54\xbb\x85\x74\xc5\xa8\x59\x77\x76\x3b\x6f\x1e\x59\xbd\xa7\x1b\x44\x36\x7d\xca\x47\x8e\xb6\x00\x6d\xcc\xa3\x8e\x0e\x11\x01\xd4\xad\xbd\x40\xd4\x55\xfe\x42\x90\x05\xf7\x1a\x85\x36\xfd\x84\x99\x09\x84\x0b\x66\xf0\x39\xd3\xbb\x9d\x7e\x3f\xbd\x7b\xfd\xd4\xf9\x33\x64\xda\x2e\x90\xd9\xe7\x08\xb0\x2c\x65\xda\x59\xbf\x8b\x9d\x9f\x2a\x40\x29\xf9\x1a\xb5\xe1\x76\xfb\x5c\x09\x30\xdd\x8b\x50\xbd\x1f\x8a\x10\x85\x56\x3f\x8d\xb5\xee\x4b\xe7\xb9\xfb\xf8\x5b\x9d\xfb\x84\x6f\x1f\xa4\x24\xd7\xf3\x7f\x53\x1b\x48\x15\x1a\xb0\x19\x37\x40\x9b\xeb\xe7\x51\x98\x5d\xb7\x24\xc5\xfc\x03\x75\x38\xa5\xc2\xd2\xa5\x16\xc0\x0d\xe8\x52\xba\x9d\x57\x49\xb0\x19\x1e\xa6\x23\xf5\x26\x1d\xc0\x07\x45\x29\xdd\x1a\xa5\x85\x9c\x09\x9e\x70\x55\x1a\x60\x89\x55\xda\xc0\x52\xab\x1c\xf0\x3e\x63\xa5\xb1\xc4\x88\xc2\x07\x5b\x33\x2e\x48\x3f\x4e\x81\x06\x94\x06\x96\x24\x65\x5e\x0a\xe6\x68\x50\xaa\x72\x95\xd5\xb2\x58\x05\x2e\xe0\x83\x50\x72\xd5\xca\x63\x0a\x96\x03\xb3\x96\x25\x77\xe6\x02\x9a\xa8\x00\x4c\x23\x58\x8e\x29\x58\x05\x89\xca\x73\x25\xe1\x5a\xa7\x50\x30\x6d\xb7\x60\x0e\x73\x0b\x96\x24\xc4\xd7\x04\xf0\x56\x6e\x95\x44\xc8\xd8\x9a\x66\x67\xf0\xa1\x3a\x4e\x5c\xc0\x97\x4a\xad\x04\x9e\x93\x80\x7f\x67\x09\x2e\x94\x6a\x87\x41\xce\xb6\xcd\xbc\xf5\x32\x36\xdc\x66\xbc\xd2\x53\x81\x3a\x27\x1e\x29\x08\x9e\x73\x6b\x82\x28\x2c\x5a\x55\xa7\xfb\x4d\x5a\xf8\x99\xd2\xfc\x17\xca\x70\x44\x6b\xae\x41\x94\xda\x5e\x94\x69\x82\xa4\x8b\xed\x02\x97\x76\x0a\xaf\xaa\x20\xd9\x07\x74\x7d\x14\x3a\x85\xe6\x86\xa7\x3b\x62\x1a\xfe\x0b\x4e\xe1\xba\xca\x6b\xc9\xd3\xa3\x30\xb5\x1d\x09\xd2\x1e\xe6\xaa\x49\x6f\x6e\x8a\xfb\x19\xf4\x93\xe3\x5a\x12\xf2\x95\x0f\xaa\xa7\x94\x35\xef\xe8\x33\x67\x77\x08\x0c\x22\xd6\x3b\x2a\xd7\x42\xbb\x53\x1d\x77\x85\x82\xd0\x6e\x10\xed\xe7\x14\x44\xe2\x6f\x2b\x86\x5c\xae\x5e\x5e\x4d\x2a\x68\xd2\x03\xb1\x7f\x79\x35\xe1\xd2\xaa\x97\x57\x93\xc9\xfd\xe4\x99\x7f\x2f\xaf\x26\x4a\xbe\xbc\x9a\xd8\x0c\x5f\x5e\x4d\x5e\x5e\x5d\x77\x41\x5d\xb5\x34\x29\x26\x51\xa1\xb1\x2f\xaf\x26\x0d\xd6\x3d\xb0\x4c\xaf\xa8\x52\xf2\x3f\x6c\xa1\x4a\x3b\x5d\x08\x26\xef\xbc\xb9\x13\x97\xd2\x0e\x87\x82\xd3\x89\x2a\x14\xcc\x10\x24\x48\x62\x87\x92\xba\x28\x62\x60\x64\x4a\xad\x55\x29\xe9\x28\x04\xb4\x66\xe7\xaa\x72\x68\x21\x67\x84\xc4\x71\x10\x2d\x74\x38\x7f\xa7\x8a\xad\xef\x98\xb8\xe1\x47\x6a\x34\x65\x41\xd5\x96\xa0\xab\x4e\x46\x07\x22\x81\x26\xbc\x99\xbc\xbe\x79\xf3\xa4\xf8\x86\xd2\x6d\xb7\x86\x56\x42\xb6\x50\x6b\x84\x2a\xb9\x5f\xa8\x7b\x60\x32\x85\x25\xd7\x08\x6c\xc3\xb6\x9f\x45\x61\x9a\xce\xcf\xf6\x98\xf9\x78\xd4\xae\x9c\xa3\xf9\x85\x28\x8d\xaf\x96\x4b\x4e\x8e\xfa\x87\x82\x70\x15\x09\xe0\x1b\x51\x9a\x0b\x28\xca\x85\xe0\x26\x03\x06\x12\x37\x10\x19\xab\x95\x5c\xcd\x5d\x6b\x42\x47\x55\xf7\x0a\x85\x32\xf6\x29\x34\x60\xbe\xc0\x34\x3d\x81\x87\x8f\x84\x03\xcd\xe7\x4c\xf8\xcf\x37\xdf\xb2\x0e\x8e\x7f\x28\x93\x35\x11\xfb\x8f\x6a\xaf\x23\xf7\xdd\x6c\x36\x41\xa3\x49\x17\x0a\x33\x14\x45\x48\xdb\x58\x29\xb9\xdd\x86\x55\x14\x54\x32\xfc\x9c\xa7\xf1\xd5\xcd\xd5\x9b\x37\x57\xaf\xfe\xf5\xe6\xf5\xeb\xab\x9b\x57\xaf\x1f\x73\xec\x16\x14\x1f\xef\xd7\xd5\x71\xe8\x2b\x45\xd5\x87\xf6\x2c\x54\xe1\xa5\x06\x01\x41\xd4\x4f\xe9\x2c\xa9\xbd\x8f\xc6\x50\x29\x29\xa1\xf4\x99\x38\x99\x0b\xfe\x06\x14\x39\x18\x3d\x21\xd9\x27\x42\xab\x81\x0f\x79\xb6\x2a\x2d\xb0\x7d\x51\x86\x2b\xd9\xc2\xe9\x02\x0c\xcf\x0b\xb1\x85\x64\x6f\xf5\xd3\xb8\x7a\xd4\x28\xbf\x0a\xab\x43\xb3\x55\x41\xc1\x65\x71\xb9\x4a\x91\xb2\x37\x53\x9a\x04\x0b\x57\xad\xa7\x8c\xe8\xaf\xdb\x5f\x98\xb4\x5c\x62\x93\x39\x05\xf0\xb5\x14\x5b\x28\x0d\xc2\x52\x69\x48\x71\x51\xae\x56\x14\x74\x94\x86\x42\xf3\x35\xb3\xd8\xa4\x4b\xa6\x46\x45\xad\xe3\x83\x13\x2a\xa5\xb7\xed\x91\x3a\x2a\xe6\xff\x50\x25\x24\x4c\x82\xd5\x2c\xb9\x73\x4b\x4b\x4a\xad\xc9\x53\x0a\xac\x82\x5a\xad\x52\x03\x0b\x14\x6a\xe3\x48\x2a\x30\x2e\x39\x0a\x97\xbd\x19\x44\xc8\xd4\x06\xf2\x32\x71\x0e\x49\xd9\x19\x52\xc7\x86\x71\x0b\xa5\xb4\x5c\x50\xb3\x06\x5b\x6a\x49\xb9\x1e\x1e\x24\x59\x15\x68\xbf\x11\xcc\xe4\x6c\xb7\x6b\xb2\xdb\x3a\xaf\xad\xd2\xb5\x26\xff\x6b\x72\xdb\xc2\x11\xd3\x55\x88\x4b\xe2\xb4\xcb\x41\xdd\xb9\x61\x2f\x6e\x4d\xfa\xad\x52\xf6\x9d\xa3\xa3\x10\xa0\x59\x62\x03\xf8\x7b\xc5\x54\x6b\x2a\xed\x28\x99\x54\x4e\x5e\x8f\xac\x4e\x4b\x47\xa5\xac\x03\xee\x64\x30\x56\x14\x82\x63\xea\x56\x72\xa8\xe2\xa3\xa2\x44\x84\xf9\xfc\x43\x86\x27\x72\xf5\xb6\x9c\x00\x1a\xdf\x55\x75\x08\x28\xb4\xb2\x98\x50\xf4\x00\xb6\x62\x5c\x1a\xf2\x7b\x97\x97\x62\xfe\x8c\x72\x43\xfb\x54\x3f\xec\x4b\xe7\xae\x3b\x0c\xe1\x4b\xa1\x16\x4c\xc0\x9a\x36\x80\x85\xa0\x73\x86\x02\x2a\xea\x1d\x98\xdf\x58\x66\x4b\x03\x6a\xe9\x5a\x2b\xc9\x69\xfc\x9a\x69\x82\x24\xe6\x85\x85\xb8\x2e\xfc\x52\x9b\x41\xbd\xae\xcb\xd9\xf4\x4a\x25\xa5\x83\xfe\x5a\x73\x06\x62\xf8\xe1\xa7\xd9\x59\x2d\xca\xdf\x70\xe9\x30\x4e\x0e\x5b\x2d\xd9\x66\xcc\x42\xa2\x91\x59\x34\x90\x08\x65\x4a\x5d\x49\x48\x75\x31\x20\x29\x1b\x4e\x0d\x67\xea\x28\xdc\x6c\x0d\x93\x51\xc6\x4c\x36\xae\xeb\xd6\x1a\x1d\xec\xda\xbe\xa6\x7d\x40\x6e\x34\x22\x06\x3c\x9e\xcc\x80\x47\x0d\xdf\x40\xa0\x5c\xd9\x6c\x06\xfc\xfc\xbc\x25\x1e\xf0\x25\x8c\x1a\x8a\x1f\xf8\x4f\x81\xbd\x0f\x68\x16\x88\x63\xe8\xce\xe6\x26\xac\xf9\x98\x42\xf0\x04\x47\xfc\x02\x2e\xc7\xb3\xa6\x77\xa1\x91\xd5\x45\xf8\xc1\xa0\xb6\x63\xf5\xcf\xfd\xee\x66\x87\x9a\x71\xca\xaf\x45\xaf\x74\x53\x15\xa5\x0c\x30\x58\x71\x63\xa1\xd4\x02\xea\xa0\x54\x99\xa0\x51\x4b\x45\xd7\xd5\xca\x11\x2e\xeb\x87\x1a\x53\xcd\x12\x2a\x36\x81\x41\x99\x8e\xfe\xfd\xbb\xaf\xbf\x0a\x8c\xd5\x5c\xae\xf8\x72\x3b\x7a\x28\xb5\x98\xc2\x8b\x91\xf7\x27\xaa\x17\x8f\x7f\x98\xfc\x14\xac\x99\x28\xf1\x82\x4e\x72\x7a\xea\x7e\x8f\x66\xb9\x80\xfa\x71\x0a\x87\x13\xee\xc6\xe3\xd9\x11\x35\x89\x3f\xe8\xd4\x1b\x35\x1a\xb4\xa3\xf1\xac\x03\xfc\xbe\x8e\x18\xe4\x68\x33\xe5\x62\x91\xc6\x44\x49\x89\x89\x85\xb2\x50\xb2\x56\x09\x08\x65\x5a\xb8\xec\x29\x3a\xaa\x39\x5c\x3b\xc4\x2e\x5b\xfc\x2f\x5c\x7c\xa7\x92\x3b\xb4\xa3\xd1\x68\xc3\x65\xaa\x36\x81\x50\xd5\xde\x41\x17\x30\x56\x25\x4a\x40\x1c\xc7\x50\xa7\x05\xde\x18\x3e\x07\x6f\x63\xe8\x16\xcc\x83\x29\x3d\xd2\xd3\x18\xce\xa1\x3f\x3c\xa3\x84\xf3\x1c\xbc\x90\x15\xdc\x1b\xcf\xce\x3a\x93\x07\x4a\xe6\x68\x0c\x5b\x61\x57\x40\x77\x64\x6f\xa4\x74\xeb\xc8\xcd\x0a\x62\x70\x06\x2a\xe8\x46\xb8\x22\x09\xa8\x4c\xd4\xa0\x8d\x30\xeb\xc8\xe2\x18\x64\x29\x44\x3b\xbe\x76\x8a\x9a\x6c\x77\x76\x40\x1e\x54\x31\xf7\xb3\x38\x06\xaa\x99\x10\x0c\xd3\xfd\x48\x32\xbe\x23\xf0\xc6\x01\x25\x13\xfb\x11\xcd\xac\xbb\x63\x6e\x98\xfe\x1a\x3b\x4c\xfb\xfc\x30\x7d\x84\xa1\x2b\xa6\x3d\xc5\xcf\x11\x74\xd9\xb9\x86\x47\xb8\xc9\x32\x5f\xa0\x7e\x8a\x9d\xdb\x0f\x1a\x76\x4e\xd5\xef\xa5\xed\x8c\xbd\x80\xcb\x37\xe3\x47\xb8\xa3\xd6\xea\x51\xe6\x74\xc1\x3a\x7a\x10\x6c\x4b\x87\x53\x18\x5a\x55\xbc\x73\xbb\xcc\xf0\xc2\xa5\x10\x53\x68\x39\x5c\xb8\x5b\x8d\x29\x0c\xdd\x1b\xf5\xf3\x1c\xdd\xa8\xd7\x93\xc9\xe4\x02\x9a\xeb\xc0\xbf\x32\x72\x42\x5d\xe2\xee\x11\x79\x4c\x99\x24\x94\xc8\x7c\x8a\x44\x35\x8f\x56\xa6\xfa\xfd\x13\xa4\x6a\x22\xe6\xa1\x58\xf0\xe7\x3f\xc3\x51\xef\x21\x8c\xc3\x10\xfe\x93\xe9\x3b\x57\xa9\xa2\xb2\x96\xab\x66\xb5\xf4\x39\x37\x86\xf6\x6f\x66\x20\x55\x12\xcf\x06\x1f\x11\xf6\x8f\x64\xac\xc9\x60\x0e\x93\xbe\x80\x3f\x4c\x0e\xb6\x85\x13\xbb\x45\x87\xef\xe1\x46\xd0\x68\xe4\xc4\x3e\xc3\x73\x84\xcf\x62\xf0\xbc\xee\xe0\x23\x0a\x22\x68\x99\x0d\x0c\xda\x0f\x95\x2d\x46\xf5\xee\x78\x6a\xef\x1a\x5f\xc0\xf5\x64\x32\x69\x8c\xd2\x9a\xa5\xfd\x1f\x86\xf0\xb6\xa0\x3c\x10\x98\xdc\xba\x90\xd8\x70\xa9\x8e\x27\x94\xd3\x51\x44\x14\x74\x37\x21\xaa\x9c\xa5\x1e\x4a\x0a\xae\xab\x7a\x31\xf8\x97\xb3\xb3\xe3\xd5\x75\x34\xd9\x59\x5a\xdf\x3c\x27\x74\xdf\x37\xd1\xa1\xce\x7a\xc4\xfe\x65\xbb\x5e\xda\xab\x0f\xec\x75\xda\x30\x83\x56\x6e\xde\x6a\xa6\xb7\x6f\xef\x55\xd5\x3e\xec\xce\x8e\xe4\xaf\xf8\x9c\x5f\x3e\x73\x19\x6d\x77\x51\x9a\xec\x00\x73\x3f\xf0\x9f\xc6\xb3\xde\x3c\x61\x08\xdf\xe2\x52\xa3\xc9\x5c\x82\xd6\xf8\x1a\x25\x6c\x64\xac\x5e\x32\x6c\x2c\x17\x02\x16\x48\xee\xe0\x52\x7d\x4c\x8f\xe5\x7d\x8e\x3b\xb4\xe4\xb7\x64\x9e\xdb\xd3\xeb\xba\x7d\xc2\x3c\xfc\x71\x73\xdc\x9e\x36\x47\x77\x68\x9d\x95\x1e\x0f\xac\x3a\x66\xa7\x47\x29\xbd\x38\x31\x44\xe9\xc5\x9e\x7e\x77\xd6\x7b\x68\xfe\x87\x21\xbc\xb7\xa8\x99\x45\x77\x11\x06\xf5\x59\x80\x4e\x72\x7d\xe8\xbb\x33\x9e\x46\x5f\xa3\x4c\xa9\x1a\x5e\xa5\x6e\xee\x10\xe5\xae\xa3\x6a\x8e\xa4\xec\xa6\x1c\xd1\x75\xdb\xdf\x68\x09\x62\x43\x61\x8d\x2e\x4a\x7b\xc1\x86\x42\x46\x7c\x10\x11\x88\x18\x05\x2b\x0c\xa6\xa4\x09\xf7\x15\xcc\x68\x1c\x94\x92\xdf\x8f\xc6\x7e\xfd\xde\xe7\xd1\xf4\xd7\xe9\x89\xf3\x8c\x4a\xec\xf3\x18\xbc\xc8\x6a\xba\x6b\x1a\x7a\x70\x0e\xa7\xac\x7b\x0e\xde\x70\xee\xcd\x4e\x0d\x05\x88\x6c\x3a\x77\x17\x21\xd5\x41\xff\x47\x8f\x2e\x5c\xe9\x43\x05\x99\x4e\x29\xa5\x1d\x1d\xb1\x65\x6b\x66\x99\x76\x5c\xc7\x33\xd8\x93\xd7\x15\x86\x84\x8c\x33\x83\xaa\x06\xef\x2e\x53\xa1\xbd\xa3\x74\x6f\x0b\xa5\x53\xd4\xbe\x66\x29\x2f\xcd\x14\x5e\x15\xf7\xb3\x1f\x9b\x3b\x5c\x77\x2b\xf4\xa4\xa8\x85\xc6\xf9\x91\x44\xf5\xed\xc2\x39\x78\x51\x48\x04\xbf\xc6\xa6\xae\x6a\xfc\xd8\x14\x5c\xdc\xd7\x37\x70\xe2\xee\x0b\xda\x6f\x63\xea\xf6\x9c\xa7\xa9\x40\x12\x78\xcf\x9e\xbc\x8a\xec\xdf\xf5\x95\xc3\x29\xa1\xbe\xf4\xda\x8f\xd9\x01\xdd\xd9\x43\xdf\x21\x6b\xaf\x7a\x24\x29\x38\x66\xdb\xd7\x44\x3d\xfe\xfc\x90\x2b\x79\xdd\xe1\x86\xfe\x39\x78\xc0\x65\xef\xa0\xfd\xa7\x3e\x37\x1a\x37\xa5\xfd\xae\x2f\xf6\xe3\x02\xb5\xd7\x7e\x43\xc2\xad\x4f\x1c\xb9\x83\x4a\x5d\xb7\x72\xcd\x7a\xe8\x4c\x58\x7f\x02\x96\x96\xda\xa5\xe2\x23\xbf\xf6\x8b\x0b\x18\x1a\x3a\x1a\xa4\x66\x38\x0e\xb2\x32\x67\x92\xff\x82\x23\xaa\x68\x51\x02\xef\xd5\xf7\x88\x1d\x5d\x9e\x3d\x26\xcc\xfe\x82\x6f\xd8\x84\xe5\x61\x6d\xfb\x61\x03\xca\x57\xfb\x5a\x16\x5d\x79\x0f\x7f\xa3\x61\x4f\xcf\xe2\x2f\x98\x6e\xb3\x2e\x7a\xf1\x9b\xdc\x0c\xb4\x12\xb8\x27\x5c\x30\x3d\xac\x2a\x77\xee\xf8\x26\xd5\x26\x1e\x5e\x4f\x5a\x21\x2b\x7c\x3a\x78\x0e\x6b\x17\xf9\xdd\x31\xf4\xec\x15\x58\xcd\x0b\x4c\xe9\x8e\x92\xaf\xf1\xff\x68\x21\x4d\x68\x9c\xc3\xf5\xe4\xf7\x10\xba\x2a\x63\xfe\x93\x17\xf2\xff\xa1\x6b\x72\xa8\x46\x79\xce\xdf\x0e\xe4\xa5\xde\x51\xd3\xfd\x2f\xf4\xfd\x05\x84\x4e\xc3\xe7\xe0\x9d\x5c\xc8\xd9\x23\x0b\xe8\x13\x1e\xf6\x3f\x11\x77\xdd\x0d\xbf\x37\xeb\xed\xe9\x74\xaa\x6b\xb0\xeb\x8d\x03\xfa\xe2\x7a\xe4\x45\x96\xbe\x80\x71\x21\xa2\xe5\x40\x91\xbd\x6e\x1e\xcf\x8e\x6a\x35\xfb\x03\x3b\xd5\xa9\x0e\x8e\xeb\x63\x78\x80\x4e\x12\xde\xd6\x1c\x9a\x8c\x1b\x76\xfb\x8f\x39\xc3\x10\xbe\xb3\x4c\x5b\x60\xf0\xfd\x7b\x28\x8b\x94\x51\xea\x66\x15\x14\x65\x3f\xb9\x5b\x30\xba\xa6\x57\x7a\xc3\x74\x5a\x17\x56\x6d\x86\x5b\x77\x97\xde\x1c\x71\x0c\xda\xf7\x94\xfc\xad\x99\x18\x75\xe5\xa1\xbe\xc1\x8b\xd1\xb0\xfd\x76\x94\xec\x3f\x1c\x07\xc8\x92\xec\x98\x70\xb0\xee\x80\x03\x62\xf8\xca\x1d\x75\x47\x2f\x46\x36\xe3\x66\x1c\x30\x6b\xf5\x68\x78\x00\x86\xe1\x98\xe2\x64\x93\xe9\x53\x10\x6b\x87\x47\x07\x6e\xf5\x14\x8f\xfd\xa1\x71\x3c\xeb\x91\x27\xc6\x8c\x2a\x5c\x0d\x2f\x3a\xbc\x0f\x61\x35\x7c\x39\x6c\x0d\xb5\x77\xef\x96\x38\x8e\x4f\x4a\x72\xc0\x7a\x48\x71\x6f\x78\x34\x3d\x4b\xd3\x77\xe4\x3f\x23\xef\x84\xa7\xf7\xd1\x31\x6e\x95\x5d\x6d\x3c\x4f\x6a\xb9\xfa\x2e\xee\x11\x15\xf3\x74\x38\x0e\x4c\xb9\x20\xd7\x94\xab\xd1\xeb\xb6\xd0\xd0\x90\x39\xf0\xf6\xf7\xb4\xa3\x84\x8e\xa6\x38\x4c\xea\x9a\xa4\xaf\x79\x7f\x62\xfb\xab\xa7\xac\x56\xb5\xbb\x20\x85\x4f\xea\xa4\x30\x0c\xe1\x0b\x43\xc9\x6d\x75\x67\xb7\xc1\x85\x71\x15\x33\xa8\xf1\xee\xaa\x96\x55\x75\xf2\xed\x37\xef\x3b\x15\xca\xd6\x23\x28\xbd\x1c\x0c\xda\x0f\xb1\x4f\xd5\x03\x4f\x7e\xf9\x4d\x37\x70\xd5\x55\xb4\xbb\x7f\x6b\x0b\x86\x54\x51\xa3\x0f\xd5\x81\x99\xad\x4c\x20\xc5\x25\xea\xfd\x77\xde\x6d\x15\x31\x0a\x9d\x5b\x9f\x45\x61\x66\x73\x31\x3f\xfb\xdf\x01\x00\x03\xd0\x4b\xfd\x87\x31\x00\x00")
 
 func faucetHtmlBytes() ([]byte, error) {
 	return bindataRead(
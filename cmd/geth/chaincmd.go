@@ -35,6 +35,7 @@ import (
 	"github.com/Onther-Tech/plasma-evm/ethdb"
 	"github.com/Onther-Tech/plasma-evm/event"
 	"github.com/Onther-Tech/plasma-evm/log"
+	"github.com/Onther-Tech/plasma-evm/pls"
 	"github.com/Onther-Tech/plasma-evm/trie"
 	"github.com/syndtr/goleveldb/leveldb/util"
 	"gopkg.in/urfave/cli.v1"
@@ -48,6 +49,8 @@ var (
 		ArgsUsage: "<genesisPath>",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.PlasmaRootChainUrlFlag,
+			utils.PlasmaRootChainContractFlag,
 		},
 		Category: "BLOCKCHAIN COMMANDS",
 		Description: `
@@ -55,7 +58,9 @@ The init command initializes a new genesis block and definition for the network.
 This is a destructive action and changes the network in which you will be
 participating.
 
-It expects the genesis file as argument.`,
+It expects the genesis file as argument. If --rootchain.contract is given, the
+genesis block is checked against the one the RootChain contract was deployed
+with before it is written.`,
 	}
 	importCommand = cli.Command{
 		Action:    utils.MigrateFlags(importChain),
@@ -73,7 +78,9 @@ It expects the genesis file as argument.`,
 		Category: "BLOCKCHAIN COMMANDS",
 		Description: `
 The import command imports blocks from an RLP-encoded form. The form can be one file
-with several RLP-encoded blocks, or several files can be used.
+with several RLP-encoded blocks, or several files can be used. Files written by the
+export command also carry the plasma metadata of each block (fork number, epoch number,
+request block ID and rootchain submission transaction), which is restored on import.
 
 If only one file is used, import error will result in failure. If several files are used,
 processing will proceed even if an individual RLP-file import failure occurs.`,
@@ -94,7 +101,7 @@ Requires a first argument of the file to write to.
 Optional second and third arguments control the first and
 last block to write. In this mode, the file will be appended
 if already existing. If the file ends with .gz, the output will
-be gzipped.`,
+be gzipped. Every block is written along with its plasma metadata.`,
 	}
 	importPreimagesCommand = cli.Command{
 		Action:    utils.MigrateFlags(importPreimages),
@@ -188,6 +195,16 @@ func initGenesis(ctx *cli.Context) error {
 	if err := json.NewDecoder(file).Decode(genesis); err != nil {
 		utils.Fatalf("invalid genesis file: %v", err)
 	}
+	// Make sure the genesis matches the RootChain contract, if any
+	if ctx.GlobalIsSet(utils.PlasmaRootChainContractFlag.Name) {
+		backend, contract, address := dialRootChain(ctx)
+		err := pls.VerifyRootChainGenesis(contract, address, genesis.ToBlock(nil).Header())
+		backend.Close()
+		if err != nil {
+			utils.Fatalf("Failed to verify genesis: %v", err)
+		}
+		log.Info("Verified genesis against RootChain contract", "contract", address)
+	}
 	// Open an initialise both full and light databases
 	stack := makeFullNode(ctx)
 	for _, name := range []string{"chaindata", "lightchaindata"} {
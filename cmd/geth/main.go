@@ -74,6 +74,7 @@ var (
 		utils.TxPoolNoLocalsFlag,
 		utils.TxPoolJournalFlag,
 		utils.TxPoolRejournalFlag,
+		utils.TxPoolRequestJournalFlag,
 		utils.TxPoolPriceLimitFlag,
 		utils.TxPoolPriceBumpFlag,
 		utils.TxPoolAccountSlotsFlag,
@@ -131,6 +132,7 @@ var (
 		utils.NoCompactionFlag,
 		utils.GpoBlocksFlag,
 		utils.GpoPercentileFlag,
+		utils.GpoFastPercentileFlag,
 		utils.EWASMInterpreterFlag,
 		utils.EVMInterpreterFlag,
 		configFileFlag,
@@ -151,10 +153,14 @@ var (
 	}
 
 	plasmaFlags = []cli.Flag{
+		utils.PlasmaOperatorFlag,
 		utils.PlasmaOperatorKeyFlag,
 		utils.PlasmaDeveloperKeyFlag,
 		utils.PlasmaRootChainUrlFlag,
 		utils.PlasmaRootChainContractFlag,
+		utils.PlasmaWithholdingTimeoutFlag,
+		utils.PlasmaWithholdingExitFlag,
+		utils.PlasmaRootChainSyncFlag,
 	}
 
 	whisperFlags = []cli.Flag{
@@ -189,6 +195,8 @@ func init() {
 		copydbCommand,
 		removedbCommand,
 		dumpCommand,
+		// See plasmacmd.go:
+		plasmaCommand,
 		// See monitorcmd.go:
 		monitorCommand,
 		// See accountcmd.go:
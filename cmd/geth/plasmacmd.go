@@ -0,0 +1,258 @@
+// Copyright 2018 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+
+	"github.com/Onther-Tech/plasma-evm"
+	"github.com/Onther-Tech/plasma-evm/accounts/keystore"
+	"github.com/Onther-Tech/plasma-evm/cmd/utils"
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/common/hexutil"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/core"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/ethclient"
+	"github.com/Onther-Tech/plasma-evm/log"
+	"github.com/Onther-Tech/plasma-evm/pls"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	exitBlockFlag = cli.Uint64Flag{
+		Name:  "exit.block",
+		Usage: "Finalized plasma block to exit from (default = last finalized block)",
+	}
+	exitOutputFlag = cli.StringFlag{
+		Name:  "exit.out",
+		Usage: "File to export the exit requests to (default = stdout)",
+	}
+	exitSendFlag = cli.BoolFlag{
+		Name:  "exit.send",
+		Usage: "Sign the exit requests with the account and send them to the rootchain",
+	}
+	genesisFileFlag = cli.StringFlag{
+		Name:  "genesis",
+		Usage: "Genesis JSON file to check against the RootChain contract (default = plasma genesis)",
+	}
+
+	plasmaCommand = cli.Command{
+		Name:      "plasma",
+		Usage:     "Manage the plasma chain on the rootchain",
+		ArgsUsage: "",
+		Category:  "PLASMA COMMANDS",
+		Description: `
+Manage the RootChain contract of the plasma chain and requests to it.`,
+		Subcommands: []cli.Command{
+			{
+				Name:      "genesis",
+				Usage:     "Write the genesis of the plasma chain managed by a RootChain contract",
+				ArgsUsage: "[file]",
+				Action:    utils.MigrateFlags(rootchainGenesis),
+				Flags: []cli.Flag{
+					utils.PlasmaRootChainUrlFlag,
+					utils.PlasmaRootChainContractFlag,
+					genesisFileFlag,
+				},
+				Description: `
+    geth plasma genesis [file]
+
+Checks the genesis given with --genesis, or the default plasma genesis, against
+the genesis block recorded by the RootChain contract on the rootchain. If they
+match, the genesis is written as JSON to the file, or to stdout. The result can
+be used with geth init to join the plasma chain.`,
+			},
+			{
+				Name:      "exit-all",
+				Usage:     "Generate exit requests for all assets of an account",
+				ArgsUsage: "<address> [endpoint]",
+				Action:    utils.MigrateFlags(exitAll),
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.KeyStoreDirFlag,
+					utils.PasswordFileFlag,
+					utils.PlasmaRootChainUrlFlag,
+					utils.PlasmaRootChainContractFlag,
+					exitBlockFlag,
+					exitOutputFlag,
+					exitSendFlag,
+				},
+				Description: `
+    geth plasma exit-all <address> [endpoint]
+
+Walks the ETH balance and the requestable token balances of the account at a
+finalized plasma block and generates the startExit requests for them. The
+requests are fetched from the plasma node attached via the endpoint.
+
+By default the requests are exported as JSON. With --exit.send they are signed
+with the account from the keystore and sent to the RootChain contract.`,
+			},
+		},
+	}
+)
+
+// dialRootChain connects to the rootchain provider and binds the RootChain
+// contract given by the command line flags.
+func dialRootChain(ctx *cli.Context) (*ethclient.Client, *rootchain.RootChain, common.Address) {
+	if !ctx.GlobalIsSet(utils.PlasmaRootChainContractFlag.Name) {
+		utils.Fatalf("RootChain contract address must be set, using --%s", utils.PlasmaRootChainContractFlag.Name)
+	}
+	address := common.HexToAddress(ctx.GlobalString(utils.PlasmaRootChainContractFlag.Name))
+
+	backend, err := ethclient.Dial(ctx.GlobalString(utils.PlasmaRootChainUrlFlag.Name))
+	if err != nil {
+		utils.Fatalf("Failed to connect to rootchain: %v", err)
+	}
+	contract, err := rootchain.NewRootChain(address, backend)
+	if err != nil {
+		utils.Fatalf("Failed to bind RootChain contract: %v", err)
+	}
+	return backend, contract, address
+}
+
+// rootchainGenesis writes the genesis of the plasma chain managed by a deployed
+// RootChain contract, once it is checked against the contract.
+func rootchainGenesis(ctx *cli.Context) error {
+	var genesis *core.Genesis
+	if path := ctx.GlobalString(genesisFileFlag.Name); path != "" {
+		file, err := os.Open(path)
+		if err != nil {
+			utils.Fatalf("Failed to read genesis file: %v", err)
+		}
+		genesis = new(core.Genesis)
+		err = json.NewDecoder(file).Decode(genesis)
+		file.Close()
+		if err != nil {
+			utils.Fatalf("Invalid genesis file: %v", err)
+		}
+	}
+	backend, contract, address := dialRootChain(ctx)
+	defer backend.Close()
+
+	genesis, err := pls.RootChainGenesis(contract, address, genesis)
+	if err != nil {
+		utils.Fatalf("Failed to verify genesis: %v", err)
+	}
+	out, err := json.MarshalIndent(genesis, "", "  ")
+	if err != nil {
+		utils.Fatalf("Failed to encode genesis: %v", err)
+	}
+	if file := ctx.Args().First(); file != "" {
+		if err := ioutil.WriteFile(file, out, 0644); err != nil {
+			utils.Fatalf("Failed to write genesis: %v", err)
+		}
+		log.Info("Genesis written", "contract", address, "hash", genesis.ToBlock(nil).Hash(), "file", file)
+		return nil
+	}
+	fmt.Fprintln(os.Stdout, string(out))
+	return nil
+}
+
+// exitAll fetches the exit requests of an account from a running plasma node,
+// and exports or sends them.
+func exitAll(ctx *cli.Context) error {
+	if len(ctx.Args()) < 1 {
+		utils.Fatalf("This command requires an address argument.")
+	}
+	if !common.IsHexAddress(ctx.Args().First()) {
+		utils.Fatalf("Invalid address: %s", ctx.Args().First())
+	}
+	requestor := common.HexToAddress(ctx.Args().First())
+
+	client, err := dialRPC(ctx.Args().Get(1))
+	if err != nil {
+		utils.Fatalf("Unable to attach to plasma node: %v", err)
+	}
+	defer client.Close()
+
+	var number *hexutil.Uint64
+	if ctx.GlobalIsSet(exitBlockFlag.Name) {
+		n := hexutil.Uint64(ctx.GlobalUint64(exitBlockFlag.Name))
+		number = &n
+	}
+	var exits pls.ExitRequests
+	if err := client.Call(&exits, "pls_getExitRequests", requestor, number); err != nil {
+		utils.Fatalf("Failed to get exit requests: %v", err)
+	}
+	log.Info("Exit requests generated", "requestor", requestor, "fork", exits.ForkNumber, "block", exits.BlockNumber, "requests", len(exits.Requests))
+
+	if !ctx.GlobalBool(exitSendFlag.Name) {
+		out, err := json.MarshalIndent(exits, "", "  ")
+		if err != nil {
+			utils.Fatalf("Failed to encode exit requests: %v", err)
+		}
+		if file := ctx.GlobalString(exitOutputFlag.Name); file != "" {
+			if err := ioutil.WriteFile(file, out, 0600); err != nil {
+				utils.Fatalf("Failed to export exit requests: %v", err)
+			}
+			return nil
+		}
+		fmt.Fprintln(os.Stdout, string(out))
+		return nil
+	}
+
+	// Sign the requests with the requestor and send them to the rootchain
+	stack, _ := makeConfigNode(ctx)
+	ks := stack.AccountManager().Backends(keystore.KeyStoreType)[0].(*keystore.KeyStore)
+	account, _ := unlockAccount(ctx, ks, requestor.Hex(), 0, utils.MakePasswordList(ctx))
+
+	backend, _, contract := dialRootChain(ctx)
+	defer backend.Close()
+
+	chainID, err := backend.NetworkID(context.Background())
+	if err != nil {
+		utils.Fatalf("Failed to get rootchain network id: %v", err)
+	}
+	nonce, err := backend.PendingNonceAt(context.Background(), requestor)
+	if err != nil {
+		utils.Fatalf("Failed to get nonce: %v", err)
+	}
+	gasPrice, err := backend.SuggestGasPrice(context.Background())
+	if err != nil {
+		utils.Fatalf("Failed to suggest gas price: %v", err)
+	}
+
+	for i, exit := range exits.Requests {
+		value := (*big.Int)(exit.Value)
+		gas, err := backend.EstimateGas(context.Background(), ethereum.CallMsg{
+			From:  requestor,
+			To:    &contract,
+			Value: value,
+			Data:  exit.Data,
+		})
+		if err != nil {
+			utils.Fatalf("Failed to estimate gas of exit #%d: %v", i, err)
+		}
+		tx := types.NewTransaction(nonce, contract, value, gas, gasPrice, exit.Data)
+		signed, err := ks.SignTx(account, tx, chainID)
+		if err != nil {
+			utils.Fatalf("Failed to sign exit #%d: %v", i, err)
+		}
+		if err := backend.SendTransaction(context.Background(), signed); err != nil {
+			utils.Fatalf("Failed to send exit #%d: %v", i, err)
+		}
+		log.Info("Exit request sent", "to", exit.To, "trieKey", exit.TrieKey, "trieValue", exit.TrieValue, "hash", signed.Hash())
+		nonce++
+	}
+	return nil
+}
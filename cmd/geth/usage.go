@@ -119,6 +119,7 @@ var AppHelpFlagGroups = []flagGroup{
 			utils.TxPoolNoLocalsFlag,
 			utils.TxPoolJournalFlag,
 			utils.TxPoolRejournalFlag,
+			utils.TxPoolRequestJournalFlag,
 			utils.TxPoolPriceLimitFlag,
 			utils.TxPoolPriceBumpFlag,
 			utils.TxPoolAccountSlotsFlag,
@@ -203,6 +204,7 @@ var AppHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			utils.GpoBlocksFlag,
 			utils.GpoPercentileFlag,
+			utils.GpoFastPercentileFlag,
 		},
 	},
 	{
@@ -232,6 +234,10 @@ var AppHelpFlagGroups = []flagGroup{
 			utils.MetricsInfluxDBHostTagFlag,
 		},
 	},
+	{
+		Name:  "PLASMA",
+		Flags: plasmaFlags,
+	},
 	{
 		Name:  "WHISPER (EXPERIMENTAL)",
 		Flags: whisperFlags,
@@ -30,9 +30,10 @@ import (
 	"github.com/Onther-Tech/plasma-evm/log"
 )
 
-// nodeDockerfile is the Dockerfile required to run an Ethereum node.
+// nodeDockerfile is the Dockerfile required to run an Ethereum node. Plasma nodes
+// run the plasma-evm build of geth, which knows the rootchain flags.
 var nodeDockerfile = `
-FROM ethereum/client-go:latest
+FROM {{if .RootChainURL}}onthertech/plasma-evm:latest{{else}}ethereum/client-go:latest{{end}}
 
 ADD genesis.json /genesis.json
 {{if .Unlock}}
@@ -42,7 +43,7 @@ ADD genesis.json /genesis.json
 RUN \
   echo 'geth --cache 512 init /genesis.json' > geth.sh && \{{if .Unlock}}
 	echo 'mkdir -p /root/.ethereum/keystore/ && cp /signer.json /root/.ethereum/keystore/' >> geth.sh && \{{end}}
-	echo $'exec geth --networkid {{.NetworkID}} --cache 512 --port {{.Port}} --nat extip:{{.IP}} --maxpeers {{.Peers}} {{.LightFlag}} --ethstats \'{{.Ethstats}}\' {{if .Bootnodes}}--bootnodes {{.Bootnodes}}{{end}} {{if .Etherbase}}--miner.etherbase {{.Etherbase}} --mine --miner.threads 1{{end}} {{if .Unlock}}--unlock 0 --password /signer.pass --mine{{end}} --miner.gastarget {{.GasTarget}} --miner.gaslimit {{.GasLimit}} --miner.gasprice {{.GasPrice}}' >> geth.sh
+	echo $'exec geth --networkid {{.NetworkID}} --cache 512 --port {{.Port}} --nat extip:{{.IP}} --maxpeers {{.Peers}} {{.LightFlag}} --ethstats \'{{.Ethstats}}\' {{if .Bootnodes}}--bootnodes {{.Bootnodes}}{{end}} {{if .Etherbase}}--miner.etherbase {{.Etherbase}} --mine --miner.threads 1{{end}} {{if .Unlock}}--unlock 0 --password /signer.pass --mine{{end}} {{if .RootChainURL}}--rootchain.url {{.RootChainURL}} --rootchain.contract {{.RootChainContract}}{{end}} {{if .Operator}}--rootchain.operator {{.Operator}}{{end}} --miner.gastarget {{.GasTarget}} --miner.gaslimit {{.GasLimit}} --miner.gasprice {{.GasPrice}}' >> geth.sh
 
 ENTRYPOINT ["/bin/sh", "geth.sh"]
 `
@@ -70,7 +71,9 @@ services:
       - MINER_NAME={{.Etherbase}}
       - GAS_TARGET={{.GasTarget}}
       - GAS_LIMIT={{.GasLimit}}
-      - GAS_PRICE={{.GasPrice}}
+      - GAS_PRICE={{.GasPrice}}{{if .RootChainURL}}
+      - ROOTCHAIN_URL={{.RootChainURL}}
+      - ROOTCHAIN_CONTRACT={{.RootChainContract}}{{end}}
     logging:
       driver: "json-file"
       options:
@@ -96,6 +99,10 @@ func deployNode(client *sshClient, network string, bootnodes []string, config *n
 	if config.peersLight > 0 {
 		lightFlag = fmt.Sprintf("--lightpeers=%d --lightserv=50", config.peersLight)
 	}
+	operator := ""
+	if config.rootchainURL != "" && config.keyJSON != "" {
+		operator = config.keyAddress()
+	}
 	dockerfile := new(bytes.Buffer)
 	template.Must(template.New("").Parse(nodeDockerfile)).Execute(dockerfile, map[string]interface{}{
 		"NetworkID": config.network,
@@ -110,6 +117,10 @@ func deployNode(client *sshClient, network string, bootnodes []string, config *n
 		"GasLimit":  uint64(1000000 * config.gasLimit),
 		"GasPrice":  uint64(1000000000 * config.gasPrice),
 		"Unlock":    config.keyJSON != "",
+		"Operator":  operator,
+
+		"RootChainURL":      config.rootchainURL,
+		"RootChainContract": config.rootchainContract,
 	})
 	files[filepath.Join(workdir, "Dockerfile")] = dockerfile.Bytes()
 
@@ -128,6 +139,9 @@ func deployNode(client *sshClient, network string, bootnodes []string, config *n
 		"GasTarget":  config.gasTarget,
 		"GasLimit":   config.gasLimit,
 		"GasPrice":   config.gasPrice,
+
+		"RootChainURL":      config.rootchainURL,
+		"RootChainContract": config.rootchainContract,
 	})
 	files[filepath.Join(workdir, "docker-compose.yaml")] = composefile.Bytes()
 
@@ -167,6 +181,9 @@ type nodeInfos struct {
 	gasTarget  float64
 	gasLimit   float64
 	gasPrice   float64
+
+	rootchainURL      string // Rootchain endpoint of a plasma node
+	rootchainContract string // RootChain contract of a plasma node
 }
 
 // Report converts the typed struct into a plain string->string map, containing
@@ -179,6 +196,11 @@ func (info *nodeInfos) Report() map[string]string {
 		"Peer count (light nodes)": strconv.Itoa(info.peersLight),
 		"Ethstats username":        info.ethstats,
 	}
+	if info.rootchainURL != "" {
+		// Plasma node, anchored to a RootChain contract
+		report["Rootchain endpoint"] = info.rootchainURL
+		report["RootChain contract"] = info.rootchainContract
+	}
 	if info.gasTarget > 0 {
 		// Miner or signer node
 		report["Gas price (minimum accepted)"] = fmt.Sprintf("%0.3f GWei", info.gasPrice)
@@ -191,20 +213,31 @@ func (info *nodeInfos) Report() map[string]string {
 			report["Miner account"] = info.etherbase
 		}
 		if info.keyJSON != "" {
-			// Clique proof-of-authority signer
-			var key struct {
-				Address string `json:"address"`
-			}
-			if err := json.Unmarshal([]byte(info.keyJSON), &key); err == nil {
-				report["Signer account"] = common.HexToAddress(key.Address).Hex()
+			// Clique proof-of-authority signer or plasma operator
+			if address := info.keyAddress(); address == "" {
+				log.Error("Failed to retrieve signer address")
+			} else if info.rootchainURL != "" {
+				report["Operator account"] = address
 			} else {
-				log.Error("Failed to retrieve signer address", "err", err)
+				report["Signer account"] = address
 			}
 		}
 	}
 	return report
 }
 
+// keyAddress returns the address of the signer's key JSON, or an empty string
+// if it cannot be parsed.
+func (info *nodeInfos) keyAddress() string {
+	var key struct {
+		Address string `json:"address"`
+	}
+	if err := json.Unmarshal([]byte(info.keyJSON), &key); err != nil {
+		return ""
+	}
+	return common.HexToAddress(key.Address).Hex()
+}
+
 // checkNode does a health-check against a boot or seal node server to verify
 // whether it's running, and if yes, whether it's responsive.
 func checkNode(client *sshClient, network string, boot bool) (*nodeInfos, error) {
@@ -266,6 +299,9 @@ func checkNode(client *sshClient, network string, boot bool) (*nodeInfos, error)
 		gasTarget:  gasTarget,
 		gasLimit:   gasLimit,
 		gasPrice:   gasPrice,
+
+		rootchainURL:      infos.envvars["ROOTCHAIN_URL"],
+		rootchainContract: infos.envvars["ROOTCHAIN_CONTRACT"],
 	}
 	stats.enode = string(enode)
 
@@ -45,6 +45,7 @@ type config struct {
 	ethstats  string   // Ethstats settings to cache for node deploys
 
 	Genesis *core.Genesis     `json:"genesis,omitempty"` // Genesis block to cache for node deploys
+	Plasma  *plasmaConfig     `json:"plasma,omitempty"`  // RootChain settings of a plasma network
 	Servers map[string][]byte `json:"servers,omitempty"`
 }
 
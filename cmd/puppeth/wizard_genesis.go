@@ -57,6 +57,7 @@ func (w *wizard) makeGenesis() {
 	fmt.Println("Which consensus engine to use? (default = clique)")
 	fmt.Println(" 1. Ethash - proof-of-work")
 	fmt.Println(" 2. Clique - proof-of-authority")
+	fmt.Println(" 3. Plasma - blocks sealed by the RootChain operator")
 
 	choice := w.read()
 	switch {
@@ -103,6 +104,26 @@ func (w *wizard) makeGenesis() {
 			copy(genesis.ExtraData[32+i*common.AddressLength:], signer[:])
 		}
 
+	case choice == "3":
+		// In case of plasma, the genesis is anchored to the RootChain contract
+		// and must match the default plasma genesis, so no pre-funds allowed
+		genesis = core.DefaultGenesisBlock()
+
+		config := *genesis.Config
+		genesis.Config = &config
+
+		fmt.Println()
+		fmt.Printf("Specify your chain/network ID if you want an explicit one (default = %d)\n", config.ChainID)
+		config.ChainID = new(big.Int).SetUint64(uint64(w.readDefaultInt(int(config.ChainID.Int64()))))
+
+		// All done, store the genesis and flush to disk
+		log.Info("Configured new plasma genesis block")
+
+		w.conf.Genesis = genesis
+		w.conf.Plasma = new(plasmaConfig)
+		w.conf.flush()
+		return
+
 	default:
 		log.Crit("Invalid consensus engine choice", "choice", choice)
 	}
@@ -271,6 +292,7 @@ func (w *wizard) manageGenesis() {
 		log.Info("Genesis block destroyed")
 
 		w.conf.Genesis = nil
+		w.conf.Plasma = nil
 		w.conf.flush()
 	default:
 		log.Error("That's not something I can do")
@@ -123,6 +123,9 @@ func (w *wizard) run() {
 		} else {
 			fmt.Println(" 4. Manage network components")
 		}
+		if w.conf.Plasma != nil {
+			fmt.Println(" 5. Deploy RootChain contract")
+		}
 
 		choice := w.read()
 		switch {
@@ -162,6 +165,9 @@ func (w *wizard) run() {
 			} else {
 				w.manageComponents()
 			}
+		case choice == "5" && w.conf.Plasma != nil:
+			w.deployRootChain()
+
 		default:
 			log.Error("That's not something I can do")
 		}
@@ -24,6 +24,7 @@ import (
 	"github.com/Onther-Tech/plasma-evm/accounts/keystore"
 	"github.com/Onther-Tech/plasma-evm/common"
 	"github.com/Onther-Tech/plasma-evm/log"
+	"github.com/Onther-Tech/plasma-evm/params"
 )
 
 // deployNode creates a new node configuration based on some user input.
@@ -37,6 +38,10 @@ func (w *wizard) deployNode(boot bool) {
 		log.Error("No ethstats server configured")
 		return
 	}
+	if w.conf.Plasma != nil && !w.conf.Plasma.deployed() {
+		log.Error("No RootChain contract deployed")
+		return
+	}
 	// Select the server to interact with
 	server := w.selectServer()
 	if server == "" {
@@ -67,7 +72,7 @@ func (w *wizard) deployNode(boot bool) {
 		fmt.Printf("Where should data be stored on the remote machine? (default = %s)\n", infos.datadir)
 		infos.datadir = w.readDefaultString(infos.datadir)
 	}
-	if w.conf.Genesis.Config.Ethash != nil && !boot {
+	if w.conf.Genesis.Config.Ethash != nil && w.conf.Plasma == nil && !boot {
 		fmt.Println()
 		if infos.ethashdir == "" {
 			fmt.Printf("Where should the ethash mining DAGs be stored on the remote machine?\n")
@@ -77,6 +82,17 @@ func (w *wizard) deployNode(boot bool) {
 			infos.ethashdir = w.readDefaultString(infos.ethashdir)
 		}
 	}
+	// Plasma nodes need to follow the RootChain contract of the network
+	if w.conf.Plasma != nil {
+		if infos.rootchainURL == "" {
+			infos.rootchainURL = w.conf.Plasma.RootChainURL
+		}
+		fmt.Println()
+		fmt.Printf("Which rootchain endpoint should the node use? (default = %s)\n", infos.rootchainURL)
+		infos.rootchainURL = w.readDefaultString(infos.rootchainURL)
+
+		infos.rootchainContract = w.conf.Plasma.RootChainContract.Hex()
+	}
 	// Figure out which port to listen on
 	fmt.Println()
 	fmt.Printf("Which TCP/UDP port to listen on? (default = %d)\n", infos.port)
@@ -103,7 +119,40 @@ func (w *wizard) deployNode(boot bool) {
 	}
 	// If the node is a miner/signer, load up needed credentials
 	if !boot {
-		if w.conf.Genesis.Config.Ethash != nil {
+		if w.conf.Plasma != nil {
+			// If a previous operator was already set, offer to reuse it
+			if infos.keyJSON != "" {
+				if key, err := keystore.DecryptKey([]byte(infos.keyJSON), infos.keyPass); err != nil || key.Address != params.Operator {
+					infos.keyJSON, infos.keyPass = "", ""
+				} else {
+					fmt.Println()
+					fmt.Printf("Reuse previous (%s) operator account (y/n)? (default = yes)\n", key.Address.Hex())
+					if !w.readDefaultYesNo(true) {
+						infos.keyJSON, infos.keyPass = "", ""
+					}
+				}
+			}
+			// Plasma operators need the keyfile and unlock password of the operator account, ask if unavailable
+			if infos.keyJSON == "" {
+				fmt.Println()
+				fmt.Println("Please paste the operator's key JSON:")
+				infos.keyJSON = w.readJSON()
+
+				fmt.Println()
+				fmt.Println("What's the unlock password for the account? (won't be echoed)")
+				infos.keyPass = w.readPassword()
+
+				key, err := keystore.DecryptKey([]byte(infos.keyJSON), infos.keyPass)
+				if err != nil {
+					log.Error("Failed to decrypt key with given passphrase")
+					return
+				}
+				if key.Address != params.Operator {
+					log.Error("Key is not the plasma operator", "have", key.Address.Hex(), "want", params.Operator.Hex())
+					return
+				}
+			}
+		} else if w.conf.Genesis.Config.Ethash != nil {
 			// Ethash based miners only need an etherbase to mine against
 			fmt.Println()
 			if infos.etherbase == "" {
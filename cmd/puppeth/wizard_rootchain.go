@@ -0,0 +1,141 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/Onther-Tech/plasma-evm/accounts/abi/bind"
+	"github.com/Onther-Tech/plasma-evm/accounts/keystore"
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/epochhandler"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/ethclient"
+	"github.com/Onther-Tech/plasma-evm/log"
+	"github.com/Onther-Tech/plasma-evm/params"
+)
+
+// plasmaConfig contains the rootchain settings of a plasma network that should
+// be saved between sessions and handed to the node deploys.
+type plasmaConfig struct {
+	RootChainURL      string         `json:"rootchainUrl,omitempty"`      // Rootchain endpoint used to deploy the contracts
+	EpochHandler      common.Address `json:"epochHandler,omitempty"`      // Address of the EpochHandler contract
+	RootChainContract common.Address `json:"rootchainContract,omitempty"` // Address of the RootChain contract
+	NRELength         uint64         `json:"nreLength,omitempty"`         // Number of blocks in a non-request epoch
+	Development       bool           `json:"development,omitempty"`       // Whether the RootChain runs in development mode
+}
+
+// deployed returns whether the RootChain contract of the network is deployed.
+func (c *plasmaConfig) deployed() bool {
+	return c.RootChainContract != (common.Address{})
+}
+
+// deployRootChain deploys the EpochHandler and the RootChain contracts, anchoring
+// the configured plasma genesis to the chosen rootchain.
+func (w *wizard) deployRootChain() {
+	// Do some sanity check before the user wastes time on input
+	if w.conf.Genesis == nil || w.conf.Plasma == nil {
+		log.Error("No plasma genesis block configured")
+		return
+	}
+	plasma := *w.conf.Plasma
+	if plasma.deployed() {
+		fmt.Println()
+		fmt.Printf("RootChain contract already deployed at %s, deploy a new one (y/n)? (default = no)\n", plasma.RootChainContract.Hex())
+		if !w.readDefaultYesNo(false) {
+			return
+		}
+	}
+	if plasma.RootChainURL == "" {
+		plasma.RootChainURL = "ws://localhost:8546"
+	}
+	if plasma.NRELength == 0 {
+		plasma.NRELength = 2
+	}
+	// Figure out where and how to deploy the contracts
+	fmt.Println()
+	fmt.Printf("Which rootchain endpoint should the contracts be deployed to? (default = %s)\n", plasma.RootChainURL)
+	plasma.RootChainURL = w.readDefaultString(plasma.RootChainURL)
+
+	fmt.Println()
+	fmt.Printf("How many blocks should a non-request epoch have? (default = %d)\n", plasma.NRELength)
+	plasma.NRELength = uint64(w.readDefaultInt(int(plasma.NRELength)))
+
+	fmt.Println()
+	fmt.Println("Should the RootChain contract run in development mode (y/n)? (default = no)")
+	plasma.Development = w.readDefaultYesNo(false)
+
+	// The operator deploys the contracts, load up its credentials
+	fmt.Println()
+	fmt.Println("Please paste the operator's key JSON:")
+	keyJSON := w.readJSON()
+
+	fmt.Println()
+	fmt.Println("What's the unlock password for the account? (won't be echoed)")
+	key, err := keystore.DecryptKey([]byte(keyJSON), w.readPassword())
+	if err != nil {
+		log.Error("Failed to decrypt key with given passphrase")
+		return
+	}
+	if key.Address != params.Operator {
+		log.Error("Key is not the plasma operator", "have", key.Address.Hex(), "want", params.Operator.Hex())
+		return
+	}
+	client, err := ethclient.Dial(plasma.RootChainURL)
+	if err != nil {
+		log.Error("Failed to connect to rootchain", "url", plasma.RootChainURL, "err", err)
+		return
+	}
+	defer client.Close()
+
+	var (
+		ctx     = context.Background()
+		opts    = bind.NewKeyedTransactor(key.PrivateKey)
+		genesis = w.conf.Genesis.ToBlock(nil)
+	)
+	log.Info("Deploying EpochHandler contract")
+	address, tx, _, err := epochhandler.DeployEpochHandler(opts, client)
+	if err != nil {
+		log.Error("Failed to deploy EpochHandler contract", "err", err)
+		return
+	}
+	if address, err = bind.WaitDeployed(ctx, client, tx); err != nil {
+		log.Error("Failed to deploy EpochHandler contract", "tx", tx.Hash().Hex(), "err", err)
+		return
+	}
+	plasma.EpochHandler = address
+
+	log.Info("Deploying RootChain contract", "epochHandler", plasma.EpochHandler.Hex(), "nreLength", plasma.NRELength, "development", plasma.Development)
+	address, tx, _, err = rootchain.DeployRootChain(opts, client, plasma.EpochHandler, plasma.Development, new(big.Int).SetUint64(plasma.NRELength), genesis.Root(), genesis.TxHash(), genesis.ReceiptHash())
+	if err != nil {
+		log.Error("Failed to deploy RootChain contract", "err", err)
+		return
+	}
+	if address, err = bind.WaitDeployed(ctx, client, tx); err != nil {
+		log.Error("Failed to deploy RootChain contract", "tx", tx.Hash().Hex(), "err", err)
+		return
+	}
+	plasma.RootChainContract = address
+
+	// All done, store the contract addresses and flush to disk
+	log.Info("Deployed RootChain contract", "address", plasma.RootChainContract.Hex())
+
+	w.conf.Plasma = &plasma
+	w.conf.flush()
+}
@@ -123,10 +123,11 @@ func ImportChain(chain *core.BlockChain, fn string) error {
 			return err
 		}
 	}
-	stream := rlp.NewStream(reader, 0)
+	stream := core.NewChainImportStream(reader)
 
 	// Run actual the import.
 	blocks := make(types.Blocks, importBatchSize)
+	metas := make([]*rawdb.PlasmaBlockMeta, importBatchSize)
 	n := 0
 	for batch := 0; ; batch++ {
 		// Load a batch of RLP blocks.
@@ -135,8 +136,8 @@ func ImportChain(chain *core.BlockChain, fn string) error {
 		}
 		i := 0
 		for ; i < importBatchSize; i++ {
-			var b types.Block
-			if err := stream.Decode(&b); err == io.EOF {
+			b, meta, err := stream.Next()
+			if err == io.EOF {
 				break
 			} else if err != nil {
 				return fmt.Errorf("at block %d: %v", n, err)
@@ -146,7 +147,7 @@ func ImportChain(chain *core.BlockChain, fn string) error {
 				i--
 				continue
 			}
-			blocks[i] = &b
+			blocks[i], metas[i] = b, meta
 			n++
 		}
 		if i == 0 {
@@ -159,11 +160,15 @@ func ImportChain(chain *core.BlockChain, fn string) error {
 		missing := missingBlocks(chain, blocks[:i])
 		if len(missing) == 0 {
 			log.Info("Skipping batch as all blocks present", "batch", batch, "first", blocks[0].Hash(), "last", blocks[i-1].Hash())
-			continue
-		}
-		if _, err := chain.InsertChain(missing); err != nil {
+		} else if _, err := chain.InsertChain(missing); err != nil {
 			return fmt.Errorf("invalid block %d: %v", n, err)
 		}
+		// Restore the plasma metadata of the batch, present blocks included.
+		for j := 0; j < i; j++ {
+			if metas[j] != nil {
+				chain.WritePlasmaBlockMeta(blocks[j].Hash(), blocks[j].NumberU64(), metas[j])
+			}
+		}
 	}
 	return nil
 }
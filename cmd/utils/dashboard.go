@@ -0,0 +1,95 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package utils
+
+import (
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/dashboard"
+	"github.com/Onther-Tech/plasma-evm/pls"
+)
+
+// dashboardPlasma feeds the plasma panel of the dashboard from the plasma
+// service, converting the rootchain status to the dashboard entries.
+type dashboardPlasma struct {
+	pls *pls.Plasma
+}
+
+func (p *dashboardPlasma) CurrentBlock() *types.Block {
+	return p.pls.BlockChain().CurrentBlock()
+}
+
+func (p *dashboardPlasma) GetBlockByNumber(number uint64) *types.Block {
+	return p.pls.BlockChain().GetBlockByNumber(number)
+}
+
+func (p *dashboardPlasma) Epochs(limit int) ([]*dashboard.EpochEntry, error) {
+	epochs, err := p.pls.RootChainManager().Epochs(limit)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*dashboard.EpochEntry, len(epochs))
+	for i, e := range epochs {
+		entries[i] = &dashboard.EpochEntry{
+			Fork:       e.ForkNumber,
+			Number:     e.EpochNumber,
+			Type:       e.Type,
+			StartBlock: e.StartBlockNumber,
+			EndBlock:   e.EndBlockNumber,
+			Empty:      e.IsEmpty,
+			Submitted:  e.Submitted,
+			Finalized:  e.Finalized,
+		}
+	}
+	return entries, nil
+}
+
+func (p *dashboardPlasma) Submissions() []*dashboard.SubmissionEntry {
+	subs := p.pls.RootChainManager().Submissions()
+
+	entries := make([]*dashboard.SubmissionEntry, len(subs))
+	for i, s := range subs {
+		entries[i] = &dashboard.SubmissionEntry{
+			Block:   s.BlockNumber,
+			Method:  s.Method,
+			TxHash:  s.TxHash.Hex(),
+			GasUsed: s.GasUsed,
+			Status:  s.Status,
+			Time:    s.Time,
+		}
+	}
+	return entries
+}
+
+func (p *dashboardPlasma) InvalidExits() []*dashboard.InvalidExitEntry {
+	exits := p.pls.RootChainManager().InvalidExits()
+
+	entries := make([]*dashboard.InvalidExitEntry, len(exits))
+	for i, e := range exits {
+		entries[i] = &dashboard.InvalidExitEntry{
+			Fork:   e.ForkNumber,
+			Block:  e.BlockNumber,
+			Index:  e.Index,
+			TxHash: e.TxHash.Hex(),
+			Reason: e.Reason,
+		}
+		if e.ChallengeTx != (common.Hash{}) {
+			entries[i].ChallengeTx = e.ChallengeTx.Hex()
+		}
+	}
+	return entries
+}
@@ -34,12 +34,15 @@ import (
 	"github.com/Onther-Tech/plasma-evm/common/fdlimit"
 	"github.com/Onther-Tech/plasma-evm/consensus"
 	"github.com/Onther-Tech/plasma-evm/consensus/clique"
+	"github.com/Onther-Tech/plasma-evm/consensus/cliqueplasma"
 	"github.com/Onther-Tech/plasma-evm/consensus/ethash"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
 	"github.com/Onther-Tech/plasma-evm/core"
 	"github.com/Onther-Tech/plasma-evm/core/state"
 	"github.com/Onther-Tech/plasma-evm/core/vm"
 	"github.com/Onther-Tech/plasma-evm/crypto"
 	"github.com/Onther-Tech/plasma-evm/dashboard"
+	"github.com/Onther-Tech/plasma-evm/ethclient"
 	"github.com/Onther-Tech/plasma-evm/ethdb"
 	"github.com/Onther-Tech/plasma-evm/ethstats"
 	"github.com/Onther-Tech/plasma-evm/les"
@@ -259,6 +262,11 @@ var (
 		Usage: "Time interval to regenerate the local transaction journal",
 		Value: core.DefaultTxPoolConfig.Rejournal,
 	}
+	TxPoolRequestJournalFlag = cli.StringFlag{
+		Name:  "txpool.requestjournal",
+		Usage: "Disk journal for request transactions to survive node restarts (disabled if empty)",
+		Value: core.DefaultTxPoolConfig.RequestJournal,
+	}
 	TxPoolPriceLimitFlag = cli.Uint64Flag{
 		Name:  "txpool.pricelimit",
 		Usage: "Minimum gas price limit to enforce for acceptance into the pool",
@@ -566,6 +574,11 @@ var (
 		Usage: "Suggested gas price is the given percentile of a set of recent transaction gas prices",
 		Value: pls.DefaultConfig.GPO.Percentile,
 	}
+	GpoFastPercentileFlag = cli.IntFlag{
+		Name:  "gpofastpercentile",
+		Usage: "Suggested gas price for fast inclusion is the given percentile of the same set of recent transaction gas prices",
+		Value: pls.DefaultConfig.GPO.FastPercentile,
+	}
 	WhisperEnabledFlag = cli.BoolFlag{
 		Name:  "shh",
 		Usage: "Enable Whisper",
@@ -625,6 +638,10 @@ var (
 	}
 
 	// Plasma flags
+	PlasmaOperatorFlag = cli.StringFlag{
+		Name:  "rootchain.operator",
+		Usage: "Plasma operator account in the keystore (unlock it with --unlock)",
+	}
 	PlasmaOperatorKeyFlag = cli.StringFlag{
 		Name:  "rootchain.operatorKey",
 		Usage: "Plasma operator key as hex(for dev)",
@@ -642,6 +659,19 @@ var (
 		Name:  "rootchain.contract",
 		Usage: "Address of the RootChain contract",
 	}
+	PlasmaWithholdingTimeoutFlag = cli.DurationFlag{
+		Name:  "rootchain.withholding.timeout",
+		Usage: "Time to wait for a submitted block before it is considered withheld (0 = disabled)",
+		Value: pls.DefaultConfig.WithholdingTimeout,
+	}
+	PlasmaWithholdingExitFlag = cli.BoolFlag{
+		Name:  "rootchain.withholding.exit",
+		Usage: "Prepare exit requests and ERUs for local accounts when a withheld block is detected",
+	}
+	PlasmaRootChainSyncFlag = cli.BoolFlag{
+		Name:  "rootchain.sync",
+		Usage: "Trust the RootChain contract instead of peers when syncing (fast sync pivot, light client headers)",
+	}
 	EWASMInterpreterFlag = cli.StringFlag{
 		Name:  "vm.ewasm",
 		Usage: "External ewasm configuration (default = built-in interpreter)",
@@ -1032,6 +1062,9 @@ func setGPO(ctx *cli.Context, cfg *gasprice.Config) {
 	if ctx.GlobalIsSet(GpoPercentileFlag.Name) {
 		cfg.Percentile = ctx.GlobalInt(GpoPercentileFlag.Name)
 	}
+	if ctx.GlobalIsSet(GpoFastPercentileFlag.Name) {
+		cfg.FastPercentile = ctx.GlobalInt(GpoFastPercentileFlag.Name)
+	}
 }
 
 func setTxPool(ctx *cli.Context, cfg *core.TxPoolConfig) {
@@ -1054,6 +1087,9 @@ func setTxPool(ctx *cli.Context, cfg *core.TxPoolConfig) {
 	if ctx.GlobalIsSet(TxPoolRejournalFlag.Name) {
 		cfg.Rejournal = ctx.GlobalDuration(TxPoolRejournalFlag.Name)
 	}
+	if ctx.GlobalIsSet(TxPoolRequestJournalFlag.Name) {
+		cfg.RequestJournal = ctx.GlobalString(TxPoolRequestJournalFlag.Name)
+	}
 	if ctx.GlobalIsSet(TxPoolPriceLimitFlag.Name) {
 		cfg.PriceLimit = ctx.GlobalUint64(TxPoolPriceLimitFlag.Name)
 	}
@@ -1262,6 +1298,17 @@ func SetPlsConfig(ctx *cli.Context, stack *node.Node, cfg *pls.Config) {
 		cfg.EVMInterpreter = ctx.GlobalString(EVMInterpreterFlag.Name)
 	}
 
+	if ctx.GlobalIsSet(PlasmaOperatorFlag.Name) {
+		account, err := MakeAddress(ks, ctx.GlobalString(PlasmaOperatorFlag.Name))
+		if err != nil {
+			Fatalf("Invalid operator account: %v", err)
+		}
+		if account.Address != params.Operator {
+			Fatalf("Faild to set operator account: %v is not operator %v", account.Address.Hex(), params.Operator.Hex())
+		}
+		cfg.Operator = account
+	}
+
 	if ctx.GlobalIsSet(PlasmaOperatorKeyFlag.Name) {
 		hex := ctx.GlobalString(PlasmaOperatorKeyFlag.Name)
 		key, _ := crypto.HexToECDSA(hex)
@@ -1316,7 +1363,18 @@ func SetPlsConfig(ctx *cli.Context, stack *node.Node, cfg *pls.Config) {
 	}
 	cfg.RootChainContract = common.HexToAddress(ctx.GlobalString(PlasmaRootChainContractFlag.Name))
 
-	cfg.Genesis = core.DefaultGenesisBlock()
+	if ctx.GlobalIsSet(PlasmaWithholdingTimeoutFlag.Name) {
+		cfg.WithholdingTimeout = ctx.GlobalDuration(PlasmaWithholdingTimeoutFlag.Name)
+	}
+	if ctx.GlobalIsSet(PlasmaWithholdingExitFlag.Name) {
+		cfg.WithholdingPrepareExit = ctx.GlobalBool(PlasmaWithholdingExitFlag.Name)
+	}
+	if ctx.GlobalBool(PlasmaRootChainSyncFlag.Name) {
+		if cfg.SyncMode == downloader.FullSync {
+			Fatalf("--%s requires fast or light sync mode", PlasmaRootChainSyncFlag.Name)
+		}
+		cfg.RootChainSync = true
+	}
 
 	// TODO(fjl): move trie cache generations into config
 	if gen := ctx.GlobalInt(TrieCacheGenFlag.Name); gen > 0 {
@@ -1356,7 +1414,14 @@ func RegisterPlsService(stack *node.Node, cfg *pls.Config) {
 // RegisterDashboardService adds a dashboard to the stack.
 func RegisterDashboardService(stack *node.Node, cfg *dashboard.Config, commit string) {
 	stack.Register(func(ctx *node.ServiceContext) (node.Service, error) {
-		return dashboard.New(cfg, commit, ctx.ResolvePath("logs")), nil
+		var plsServ *pls.Plasma
+		ctx.Service(&plsServ)
+
+		var plasma dashboard.PlasmaBackend
+		if plsServ != nil {
+			plasma = &dashboardPlasma{plsServ}
+		}
+		return dashboard.New(cfg, commit, ctx.ResolvePath("logs"), plasma), nil
 	})
 }
 
@@ -1437,6 +1502,31 @@ func MakeGenesis(ctx *cli.Context) *core.Genesis {
 	return genesis
 }
 
+// makeOperatorEngine creates the operator-signed consensus engine with the
+// operator of the RootChain contract set from command line flags.
+func makeOperatorEngine(ctx *cli.Context, config *params.CliqueConfig) *cliqueplasma.Clique {
+	if !ctx.GlobalIsSet(PlasmaRootChainContractFlag.Name) {
+		Fatalf("RootChain contract address must be set to verify the operator signatures, using --%s", PlasmaRootChainContractFlag.Name)
+	}
+	backend, err := ethclient.Dial(ctx.GlobalString(PlasmaRootChainUrlFlag.Name))
+	if err != nil {
+		Fatalf("Failed to connect to rootchain: %v", err)
+	}
+	defer backend.Close()
+
+	contract, err := rootchain.NewRootChain(common.HexToAddress(ctx.GlobalString(PlasmaRootChainContractFlag.Name)), backend)
+	if err != nil {
+		Fatalf("Failed to bind RootChain contract: %v", err)
+	}
+	operator, err := contract.Operator(nil)
+	if err != nil {
+		Fatalf("Failed to retrieve the operator: %v", err)
+	}
+	engine := cliqueplasma.New(config)
+	engine.SetOperator(operator)
+	return engine
+}
+
 // MakeChain creates a chain manager from set command line flags.
 func MakeChain(ctx *cli.Context, stack *node.Node) (chain *core.BlockChain, chainDb ethdb.Database) {
 	var err error
@@ -1448,6 +1538,8 @@ func MakeChain(ctx *cli.Context, stack *node.Node) (chain *core.BlockChain, chai
 	var engine consensus.Engine
 	if config.Clique != nil {
 		engine = clique.New(config.Clique, chainDb)
+	} else if config.CliquePlasma != nil {
+		engine = makeOperatorEngine(ctx, config.CliquePlasma)
 	} else {
 		engine = ethash.NewFaker()
 		if !ctx.GlobalBool(FakePoWFlag.Name) {
@@ -0,0 +1,100 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package cliqueplasma
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/crypto"
+	"github.com/Onther-Tech/plasma-evm/params"
+)
+
+// testChain is a consensus.ChainReader serving the headers of a fake chain.
+type testChain struct {
+	headers map[common.Hash]*types.Header
+}
+
+func (c *testChain) Config() *params.ChainConfig  { return params.AllCliqueProtocolChanges }
+func (c *testChain) CurrentHeader() *types.Header { return nil }
+func (c *testChain) GetHeader(hash common.Hash, number uint64) *types.Header {
+	return c.headers[hash]
+}
+func (c *testChain) GetHeaderByNumber(number uint64) *types.Header  { return nil }
+func (c *testChain) GetHeaderByHash(hash common.Hash) *types.Header { return c.headers[hash] }
+func (c *testChain) GetBlock(hash common.Hash, number uint64) *types.Block {
+	return nil
+}
+
+// Tests that only the headers signed by the operator are accepted.
+func TestVerifyOperatorSeal(t *testing.T) {
+	operatorKey, _ := crypto.GenerateKey()
+	otherKey, _ := crypto.GenerateKey()
+	operator := crypto.PubkeyToAddress(operatorKey.PublicKey)
+
+	genesis := &types.Header{Number: big.NewInt(0), Time: big.NewInt(0), Difficulty: big.NewInt(0)}
+	chain := &testChain{headers: map[common.Hash]*types.Header{genesis.Hash(): genesis}}
+
+	engine := New(&params.CliqueConfig{Period: 1})
+
+	// sign prepares a child header of the genesis and signs it with the key
+	sign := func(key *ecdsa.PrivateKey) *types.Header {
+		header := &types.Header{ParentHash: genesis.Hash(), Number: big.NewInt(1), UncleHash: uncleHash}
+		if err := engine.Prepare(chain, header); err != nil {
+			t.Fatalf("failed to prepare header: %v", err)
+		}
+		header.Time = big.NewInt(1)
+
+		sig, err := crypto.Sign(sigHash(header).Bytes(), key)
+		if err != nil {
+			t.Fatalf("failed to sign header: %v", err)
+		}
+		copy(header.Extra[len(header.Extra)-extraSeal:], sig)
+		return header
+	}
+	byOperator := sign(operatorKey)
+	byOther := sign(otherKey)
+
+	if err := engine.VerifyHeader(chain, byOperator, true); err != errUnknownOperator {
+		t.Fatalf("verification without operator: have %v, want %v", err, errUnknownOperator)
+	}
+	engine.SetOperator(operator)
+
+	if err := engine.VerifyHeader(chain, byOperator, true); err != nil {
+		t.Errorf("operator header rejected: %v", err)
+	}
+	if err := engine.VerifyHeader(chain, byOther, true); err != errUnauthorizedSigner {
+		t.Errorf("non-operator header error mismatch: have %v, want %v", err, errUnauthorizedSigner)
+	}
+	if author, err := engine.Author(byOperator); err != nil || author != operator {
+		t.Errorf("author mismatch: have %x (%v), want %x", author, err, operator)
+	}
+
+	tooEarly := types.CopyHeader(byOperator)
+	tooEarly.Time = big.NewInt(0)
+	if err := engine.VerifyHeader(chain, tooEarly, true); err != ErrInvalidTimestamp {
+		t.Errorf("early header error mismatch: have %v, want %v", err, ErrInvalidTimestamp)
+	}
+	wrongDiff := types.CopyHeader(byOperator)
+	wrongDiff.Difficulty = big.NewInt(2)
+	if err := engine.VerifyHeader(chain, wrongDiff, true); err != errInvalidDifficulty {
+		t.Errorf("difficulty error mismatch: have %v, want %v", err, errInvalidDifficulty)
+	}
+}
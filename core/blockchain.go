@@ -470,7 +470,8 @@ func (bc *BlockChain) Export(w io.Writer) error {
 	return bc.ExportN(w, uint64(0), bc.CurrentBlock().NumberU64())
 }
 
-// ExportN writes a subset of the active chain to the given writer.
+// ExportN writes a subset of the active chain to the given writer in the
+// extended export format, carrying the plasma metadata of every block.
 func (bc *BlockChain) ExportN(w io.Writer, first uint64, last uint64) error {
 	bc.mu.RLock()
 	defer bc.mu.RUnlock()
@@ -480,13 +481,17 @@ func (bc *BlockChain) ExportN(w io.Writer, first uint64, last uint64) error {
 	}
 	log.Info("Exporting batch of blocks", "count", last-first+1)
 
+	if err := writeChainExportHeader(w); err != nil {
+		return err
+	}
 	start, reported := time.Now(), time.Now()
 	for nr := first; nr <= last; nr++ {
 		block := bc.GetBlockByNumber(nr)
 		if block == nil {
 			return fmt.Errorf("export failed on #%d: not found", nr)
 		}
-		if err := block.EncodeRLP(w); err != nil {
+		meta := rawdb.ReadPlasmaBlockMeta(bc.db, block.Hash(), block.NumberU64())
+		if err := writeChainExportEntry(w, block, meta); err != nil {
 			return err
 		}
 		if time.Since(reported) >= statsReportLimit {
@@ -654,6 +659,16 @@ func (bc *BlockChain) GetReceiptsByHash(hash common.Hash) types.Receipts {
 	return receipts
 }
 
+// GetPlasmaBlockMeta retrieves the plasma metadata of a block from the database.
+func (bc *BlockChain) GetPlasmaBlockMeta(hash common.Hash, number uint64) *rawdb.PlasmaBlockMeta {
+	return rawdb.ReadPlasmaBlockMeta(bc.db, hash, number)
+}
+
+// WritePlasmaBlockMeta stores the plasma metadata of a block into the database.
+func (bc *BlockChain) WritePlasmaBlockMeta(hash common.Hash, number uint64, meta *rawdb.PlasmaBlockMeta) {
+	rawdb.WritePlasmaBlockMeta(bc.db, hash, number, meta)
+}
+
 // GetBlocksFromHash returns the block corresponding to hash and up to n-1 ancestors.
 // [deprecated by eth/62]
 func (bc *BlockChain) GetBlocksFromHash(hash common.Hash, n int) (blocks []*types.Block) {
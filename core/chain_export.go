@@ -0,0 +1,110 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/Onther-Tech/plasma-evm/core/rawdb"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/rlp"
+)
+
+// ChainExportVersion is the version of the extended chain export format. An
+// extended export starts with a header item carrying the version, followed by
+// one entry per block holding the block and its plasma metadata.
+const ChainExportVersion = 1
+
+// chainExportMagic marks the header item of an extended chain export.
+const chainExportMagic = "plasma-chain-export"
+
+// chainExportHeader is the versioned header item of an extended chain export.
+type chainExportHeader struct {
+	Magic   string
+	Version uint64
+}
+
+// chainExportEntry is a single block of an extended chain export. Meta holds
+// at most one element and is empty if the block has no plasma metadata.
+type chainExportEntry struct {
+	Block *types.Block
+	Meta  []*rawdb.PlasmaBlockMeta `rlp:"tail"`
+}
+
+// writeChainExportHeader writes the header item of an extended chain export.
+func writeChainExportHeader(w io.Writer) error {
+	return rlp.Encode(w, &chainExportHeader{Magic: chainExportMagic, Version: ChainExportVersion})
+}
+
+// writeChainExportEntry writes a block and its plasma metadata, if any.
+func writeChainExportEntry(w io.Writer, block *types.Block, meta *rawdb.PlasmaBlockMeta) error {
+	entry := &chainExportEntry{Block: block}
+	if meta != nil {
+		entry.Meta = []*rawdb.PlasmaBlockMeta{meta}
+	}
+	return rlp.Encode(w, entry)
+}
+
+// ChainImportStream reads blocks and their plasma metadata from a chain
+// export. Both the extended format and plain RLP block streams are accepted;
+// since exports may be appended to existing files, a header item switches the
+// stream to the version it announces at any position.
+type ChainImportStream struct {
+	stream  *rlp.Stream
+	version uint64 // Version of the extended format, 0 for plain RLP blocks
+}
+
+// NewChainImportStream creates a stream reading a chain export from r.
+func NewChainImportStream(r io.Reader) *ChainImportStream {
+	return &ChainImportStream{stream: rlp.NewStream(r, 0)}
+}
+
+// Next returns the next block of the export along with its plasma metadata,
+// which is nil if the export doesn't carry any for the block. io.EOF is
+// returned at the end of the stream.
+func (s *ChainImportStream) Next() (*types.Block, *rawdb.PlasmaBlockMeta, error) {
+	for {
+		raw, err := s.stream.Raw()
+		if err != nil {
+			return nil, nil, err
+		}
+		var header chainExportHeader
+		if err := rlp.DecodeBytes(raw, &header); err == nil && header.Magic == chainExportMagic {
+			if header.Version == 0 || header.Version > ChainExportVersion {
+				return nil, nil, fmt.Errorf("unsupported chain export version %d", header.Version)
+			}
+			s.version = header.Version
+			continue
+		}
+		if s.version == 0 {
+			block := new(types.Block)
+			if err := rlp.DecodeBytes(raw, block); err != nil {
+				return nil, nil, err
+			}
+			return block, nil, nil
+		}
+		var entry chainExportEntry
+		if err := rlp.DecodeBytes(raw, &entry); err != nil {
+			return nil, nil, err
+		}
+		if len(entry.Meta) > 0 {
+			return entry.Block, entry.Meta[0], nil
+		}
+		return entry.Block, nil, nil
+	}
+}
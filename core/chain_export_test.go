@@ -0,0 +1,74 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"bytes"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/core/rawdb"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+)
+
+// Tests that plain RLP block streams and extended exports, appended one after
+// the other, are read back with their plasma metadata.
+func TestChainImportStream(t *testing.T) {
+	blocks := make([]*types.Block, 4)
+	for i := range blocks {
+		blocks[i] = types.NewBlockWithHeader(&types.Header{Number: big.NewInt(int64(i + 1))})
+	}
+	metas := []*rawdb.PlasmaBlockMeta{
+		nil,
+		nil,
+		{ForkNumber: 0, EpochNumber: 1, SubmissionTx: common.HexToHash("0x03")},
+		{ForkNumber: 0, EpochNumber: 2, RequestBlockId: 1, SubmissionTx: common.HexToHash("0x04")},
+	}
+	// Legacy blocks first, then an appended extended export
+	buf := new(bytes.Buffer)
+	for _, block := range blocks[:2] {
+		if err := block.EncodeRLP(buf); err != nil {
+			t.Fatalf("failed to encode block: %v", err)
+		}
+	}
+	if err := writeChainExportHeader(buf); err != nil {
+		t.Fatalf("failed to write export header: %v", err)
+	}
+	for i := 2; i < len(blocks); i++ {
+		if err := writeChainExportEntry(buf, blocks[i], metas[i]); err != nil {
+			t.Fatalf("failed to write export entry: %v", err)
+		}
+	}
+	stream := NewChainImportStream(buf)
+	for i := range blocks {
+		block, meta, err := stream.Next()
+		if err != nil {
+			t.Fatalf("block %d: failed to read: %v", i, err)
+		}
+		if block.Hash() != blocks[i].Hash() {
+			t.Errorf("block %d: hash mismatch: have %x, want %x", i, block.Hash(), blocks[i].Hash())
+		}
+		if (meta == nil) != (metas[i] == nil) || (meta != nil && *meta != *metas[i]) {
+			t.Errorf("block %d: metadata mismatch: have %v, want %v", i, meta, metas[i])
+		}
+	}
+	if _, _, err := stream.Next(); err != io.EOF {
+		t.Fatalf("stream not exhausted: %v", err)
+	}
+}
@@ -354,6 +354,7 @@ func DeleteBlock(db DatabaseDeleter, hash common.Hash, number uint64) {
 	DeleteHeader(db, hash, number)
 	DeleteBody(db, hash, number)
 	DeleteTd(db, hash, number)
+	DeletePlasmaBlockMeta(db, hash, number)
 }
 
 // FindCommonAncestor returns the last common ancestor of two block headers
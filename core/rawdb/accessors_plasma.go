@@ -0,0 +1,64 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/log"
+	"github.com/Onther-Tech/plasma-evm/rlp"
+)
+
+// PlasmaBlockMeta is the positional metadata of a plasma block in the
+// RootChain contract.
+type PlasmaBlockMeta struct {
+	ForkNumber     uint64
+	EpochNumber    uint64
+	RequestBlockId uint64      // ID of the request block in the RootChain contract, 0 for non-request blocks
+	SubmissionTx   common.Hash // Hash of the rootchain transaction that submitted the block
+}
+
+// ReadPlasmaBlockMeta retrieves the plasma metadata of a block.
+func ReadPlasmaBlockMeta(db DatabaseReader, hash common.Hash, number uint64) *PlasmaBlockMeta {
+	data, _ := db.Get(plasmaMetaKey(number, hash))
+	if len(data) == 0 {
+		return nil
+	}
+	meta := new(PlasmaBlockMeta)
+	if err := rlp.DecodeBytes(data, meta); err != nil {
+		log.Error("Invalid plasma block metadata RLP", "hash", hash, "err", err)
+		return nil
+	}
+	return meta
+}
+
+// WritePlasmaBlockMeta stores the plasma metadata of a block.
+func WritePlasmaBlockMeta(db DatabaseWriter, hash common.Hash, number uint64, meta *PlasmaBlockMeta) {
+	data, err := rlp.EncodeToBytes(meta)
+	if err != nil {
+		log.Crit("Failed to encode plasma block metadata", "err", err)
+	}
+	if err := db.Put(plasmaMetaKey(number, hash), data); err != nil {
+		log.Crit("Failed to store plasma block metadata", "err", err)
+	}
+}
+
+// DeletePlasmaBlockMeta removes the plasma metadata of a block.
+func DeletePlasmaBlockMeta(db DatabaseDeleter, hash common.Hash, number uint64) {
+	if err := db.Delete(plasmaMetaKey(number, hash)); err != nil {
+		log.Crit("Failed to delete plasma block metadata", "err", err)
+	}
+}
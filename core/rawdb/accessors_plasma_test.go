@@ -0,0 +1,126 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/ethdb"
+)
+
+// Tests plasma block metadata storage and retrieval operations.
+func TestPlasmaBlockMetaStorage(t *testing.T) {
+	db := ethdb.NewMemDatabase()
+
+	block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(42)})
+	meta := &PlasmaBlockMeta{
+		ForkNumber:     1,
+		EpochNumber:    7,
+		RequestBlockId: 3,
+		SubmissionTx:   common.HexToHash("0x01"),
+	}
+	if entry := ReadPlasmaBlockMeta(db, block.Hash(), block.NumberU64()); entry != nil {
+		t.Fatalf("Non existent metadata returned: %v", entry)
+	}
+	WritePlasmaBlockMeta(db, block.Hash(), block.NumberU64(), meta)
+	if entry := ReadPlasmaBlockMeta(db, block.Hash(), block.NumberU64()); entry == nil {
+		t.Fatalf("Stored metadata not found")
+	} else if *entry != *meta {
+		t.Fatalf("Retrieved metadata mismatch: have %v, want %v", entry, meta)
+	}
+	// Deleting the block should drop its metadata as well
+	DeleteBlock(db, block.Hash(), block.NumberU64())
+	if entry := ReadPlasmaBlockMeta(db, block.Hash(), block.NumberU64()); entry != nil {
+		t.Fatalf("Deleted metadata returned: %v", entry)
+	}
+}
+
+// Tests rootchain event index storage and retrieval operations, and that the
+// events of different contracts and event types are kept apart.
+func TestRootChainEventStorage(t *testing.T) {
+	db := ethdb.NewMemDatabase()
+
+	contract, other := common.HexToAddress("0x0a"), common.HexToAddress("0x0b")
+	id := common.HexToHash("0x0c")
+
+	event := &RootChainEvent{
+		Name:        "RequestCreated",
+		BlockNumber: 10,
+		BlockHash:   common.HexToHash("0x01"),
+		TxHash:      common.HexToHash("0x02"),
+		LogIndex:    3,
+		Topics:      []common.Hash{id},
+		Data:        []byte{0x04},
+		Fields:      []byte(`{"requestId":"0x0"}`),
+		Requestor:   common.HexToAddress("0x05"),
+	}
+	if count := ReadRootChainEventCount(db, contract, id); count != 0 {
+		t.Fatalf("Non zero event count in pristine database: %d", count)
+	}
+	if entry := ReadRootChainEvent(db, contract, id, 0); entry != nil {
+		t.Fatalf("Non existent event returned: %v", entry)
+	}
+	WriteRootChainEvent(db, contract, id, 0, event)
+	WriteRootChainEventCount(db, contract, id, 1)
+	WriteRootChainEventProgress(db, contract, 10)
+
+	if count := ReadRootChainEventCount(db, contract, id); count != 1 {
+		t.Fatalf("Event count mismatch: have %d, want %d", count, 1)
+	}
+	if number := ReadRootChainEventProgress(db, contract); number != 10 {
+		t.Fatalf("Event progress mismatch: have %d, want %d", number, 10)
+	}
+	if entry := ReadRootChainEvent(db, contract, id, 0); entry == nil {
+		t.Fatalf("Stored event not found")
+	} else if !reflect.DeepEqual(entry, event) {
+		t.Fatalf("Retrieved event mismatch: have %v, want %v", entry, event)
+	}
+	// Other contracts and event types have their own events
+	if count := ReadRootChainEventCount(db, other, id); count != 0 {
+		t.Fatalf("Event count of other contract mismatch: have %d, want 0", count)
+	}
+	if number := ReadRootChainEventProgress(db, other); number != 0 {
+		t.Fatalf("Event progress of other contract mismatch: have %d, want 0", number)
+	}
+	if entry := ReadRootChainEvent(db, contract, common.Hash{}, 0); entry != nil {
+		t.Fatalf("Event returned for other event type: %v", entry)
+	}
+	DeleteRootChainEvent(db, contract, id, 0)
+	if entry := ReadRootChainEvent(db, contract, id, 0); entry != nil {
+		t.Fatalf("Deleted event returned: %v", entry)
+	}
+}
+
+// Tests the storage of the requestable contracts mapped through the node.
+func TestRequestableContractsStorage(t *testing.T) {
+	db := ethdb.NewMemDatabase()
+
+	if contracts := ReadRequestableContracts(db); len(contracts) != 0 {
+		t.Fatalf("Non empty contracts in pristine database: %v", contracts)
+	}
+	contracts := []common.Address{common.HexToAddress("0x01"), common.HexToAddress("0x02")}
+	WriteRequestableContracts(db, contracts)
+
+	stored := ReadRequestableContracts(db)
+	if len(stored) != len(contracts) || stored[0] != contracts[0] || stored[1] != contracts[1] {
+		t.Fatalf("Retrieved contracts mismatch: have %v, want %v", stored, contracts)
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/log"
+	"github.com/Onther-Tech/plasma-evm/rlp"
+)
+
+// RootChainEvent is a RootChain contract event seen by the plasma node. Events
+// are indexed per contract in the order they were emitted on the rootchain, in
+// a list of all events keyed by the zero event id and a list per event type
+// keyed by the event id.
+type RootChainEvent struct {
+	Name        string
+	BlockNumber uint64 // Rootchain block number
+	BlockHash   common.Hash
+	TxHash      common.Hash
+	LogIndex    uint64
+	Topics      []common.Hash
+	Data        []byte
+	Fields      []byte // JSON encoded decoded event fields
+
+	// Decoded fields the index can be filtered by, zero if the event has none
+	Fork      uint64
+	Epoch     uint64
+	Requestor common.Address
+}
+
+// ReadRootChainEventCount retrieves the number of indexed events of the contract
+// in the list of the given event id.
+func ReadRootChainEventCount(db DatabaseReader, contract common.Address, id common.Hash) uint64 {
+	var count uint64
+
+	enc, _ := db.Get(rootchainEventCountKey(contract, id))
+	rlp.DecodeBytes(enc, &count)
+
+	return count
+}
+
+// WriteRootChainEventCount stores the number of indexed events of the contract
+// in the list of the given event id.
+func WriteRootChainEventCount(db DatabaseWriter, contract common.Address, id common.Hash, count uint64) {
+	enc, _ := rlp.EncodeToBytes(count)
+	if err := db.Put(rootchainEventCountKey(contract, id), enc); err != nil {
+		log.Crit("Failed to store rootchain event count", "err", err)
+	}
+}
+
+// ReadRootChainEventProgress retrieves the last rootchain block whose events of
+// the contract are fully indexed.
+func ReadRootChainEventProgress(db DatabaseReader, contract common.Address) uint64 {
+	var number uint64
+
+	enc, _ := db.Get(rootchainEventProgressKey(contract))
+	rlp.DecodeBytes(enc, &number)
+
+	return number
+}
+
+// WriteRootChainEventProgress stores the last rootchain block whose events of
+// the contract are fully indexed.
+func WriteRootChainEventProgress(db DatabaseWriter, contract common.Address, number uint64) {
+	enc, _ := rlp.EncodeToBytes(number)
+	if err := db.Put(rootchainEventProgressKey(contract), enc); err != nil {
+		log.Crit("Failed to store rootchain event progress", "err", err)
+	}
+}
+
+// ReadRootChainEvent retrieves the event of the contract at the given index of
+// the list of the event id.
+func ReadRootChainEvent(db DatabaseReader, contract common.Address, id common.Hash, index uint64) *RootChainEvent {
+	data, _ := db.Get(rootchainEventKey(contract, id, index))
+	if len(data) == 0 {
+		return nil
+	}
+	event := new(RootChainEvent)
+	if err := rlp.DecodeBytes(data, event); err != nil {
+		log.Error("Invalid rootchain event RLP", "contract", contract, "id", id, "index", index, "err", err)
+		return nil
+	}
+	return event
+}
+
+// WriteRootChainEvent stores the event of the contract at the given index of the
+// list of the event id.
+func WriteRootChainEvent(db DatabaseWriter, contract common.Address, id common.Hash, index uint64, event *RootChainEvent) {
+	data, err := rlp.EncodeToBytes(event)
+	if err != nil {
+		log.Crit("Failed to encode rootchain event", "err", err)
+	}
+	if err := db.Put(rootchainEventKey(contract, id, index), data); err != nil {
+		log.Crit("Failed to store rootchain event", "err", err)
+	}
+}
+
+// DeleteRootChainEvent removes the event of the contract at the given index of
+// the list of the event id.
+func DeleteRootChainEvent(db DatabaseDeleter, contract common.Address, id common.Hash, index uint64) {
+	if err := db.Delete(rootchainEventKey(contract, id, index)); err != nil {
+		log.Crit("Failed to delete rootchain event", "err", err)
+	}
+}
+
+// ReadRequestableContracts retrieves the rootchain addresses of the requestable
+// contracts mapped through this node.
+func ReadRequestableContracts(db DatabaseReader) []common.Address {
+	var contracts []common.Address
+
+	enc, _ := db.Get(requestableContractsKey)
+	if len(enc) == 0 {
+		return nil
+	}
+	if err := rlp.DecodeBytes(enc, &contracts); err != nil {
+		log.Error("Invalid requestable contracts RLP", "err", err)
+		return nil
+	}
+	return contracts
+}
+
+// WriteRequestableContracts stores the rootchain addresses of the requestable
+// contracts mapped through this node.
+func WriteRequestableContracts(db DatabaseWriter, contracts []common.Address) {
+	enc, err := rlp.EncodeToBytes(contracts)
+	if err != nil {
+		log.Crit("Failed to encode requestable contracts", "err", err)
+	}
+	if err := db.Put(requestableContractsKey, enc); err != nil {
+		log.Crit("Failed to store requestable contracts", "err", err)
+	}
+}
@@ -41,6 +41,9 @@ var (
 	// fastTrieProgressKey tracks the number of trie entries imported during fast sync.
 	fastTrieProgressKey = []byte("TrieSync")
 
+	// requestableContractsKey tracks the requestable contracts mapped through this node.
+	requestableContractsKey = []byte("RequestableContracts")
+
 	// Data item prefixes (use single byte to avoid mixing data types, avoid `i`, used for indexes).
 	headerPrefix       = []byte("h") // headerPrefix + num (uint64 big endian) + hash -> header
 	headerTDSuffix     = []byte("t") // headerPrefix + num (uint64 big endian) + hash + headerTDSuffix -> td
@@ -53,6 +56,12 @@ var (
 	txLookupPrefix  = []byte("l") // txLookupPrefix + hash -> transaction/receipt lookup metadata
 	bloomBitsPrefix = []byte("B") // bloomBitsPrefix + bit (uint16 big endian) + section (uint64 big endian) + hash -> bloom bits
 
+	plasmaMetaPrefix     = []byte("p") // plasmaMetaPrefix + num (uint64 big endian) + hash -> plasma block metadata
+	rootchainEventPrefix = []byte("e") // rootchainEventPrefix + contract + event id + index (uint64 big endian) -> rootchain event
+
+	rootchainEventCountPrefix    = []byte("RootChainEventCount")    // rootchainEventCountPrefix + contract + event id -> number of indexed events
+	rootchainEventProgressPrefix = []byte("RootChainEventProgress") // rootchainEventProgressPrefix + contract -> last fully indexed rootchain block
+
 	preimagePrefix = []byte("secure-key-")      // preimagePrefix + hash -> preimage
 	configPrefix   = []byte("ethereum-config-") // config prefix for the db
 
@@ -108,6 +117,26 @@ func blockReceiptsKey(number uint64, hash common.Hash) []byte {
 	return append(append(blockReceiptsPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
 }
 
+// plasmaMetaKey = plasmaMetaPrefix + num (uint64 big endian) + hash
+func plasmaMetaKey(number uint64, hash common.Hash) []byte {
+	return append(append(plasmaMetaPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
+}
+
+// rootchainEventKey = rootchainEventPrefix + contract + event id + index (uint64 big endian)
+func rootchainEventKey(contract common.Address, id common.Hash, index uint64) []byte {
+	return append(append(append(rootchainEventPrefix, contract.Bytes()...), id.Bytes()...), encodeBlockNumber(index)...)
+}
+
+// rootchainEventCountKey = rootchainEventCountPrefix + contract + event id
+func rootchainEventCountKey(contract common.Address, id common.Hash) []byte {
+	return append(append(rootchainEventCountPrefix, contract.Bytes()...), id.Bytes()...)
+}
+
+// rootchainEventProgressKey = rootchainEventProgressPrefix + contract
+func rootchainEventProgressKey(contract common.Address) []byte {
+	return append(rootchainEventProgressPrefix, contract.Bytes()...)
+}
+
 // txLookupKey = txLookupPrefix + hash
 func txLookupKey(hash common.Hash) []byte {
 	return append(txLookupPrefix, hash.Bytes()...)
@@ -0,0 +1,54 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/Onther-Tech/plasma-evm/crypto"
+)
+
+// revertSelector is the function selector of Error(string), which solidity
+// uses to encode the reason of a failed require or revert.
+var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+// errNoRevertReason is returned if the output of a reverted execution doesn't
+// carry an Error(string) payload.
+var errNoRevertReason = errors.New("no revert reason")
+
+// UnpackRevertReason decodes the Error(string) reason from the output of a
+// reverted execution.
+func UnpackRevertReason(ret []byte) (string, error) {
+	if len(ret) < 4+64 || !bytes.Equal(ret[:4], revertSelector) {
+		return "", errNoRevertReason
+	}
+	data := ret[4:]
+
+	offset := new(big.Int).SetBytes(data[:32])
+	if !offset.IsUint64() || offset.Uint64() > uint64(len(data)-32) {
+		return "", errNoRevertReason
+	}
+	start := offset.Uint64() + 32
+
+	length := new(big.Int).SetBytes(data[offset.Uint64():start])
+	if !length.IsUint64() || length.Uint64() > uint64(len(data))-start {
+		return "", errNoRevertReason
+	}
+	return string(data[start : start+length.Uint64()]), nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+)
+
+func TestUnpackRevertReason(t *testing.T) {
+	tests := []struct {
+		output string
+		reason string
+		err    error
+	}{
+		// revert("nope")
+		{"08c379a0" +
+			"0000000000000000000000000000000000000000000000000000000000000020" +
+			"0000000000000000000000000000000000000000000000000000000000000004" +
+			"6e6f706500000000000000000000000000000000000000000000000000000000", "nope", nil},
+		// revert("")
+		{"08c379a0" +
+			"0000000000000000000000000000000000000000000000000000000000000020" +
+			"0000000000000000000000000000000000000000000000000000000000000000", "", nil},
+		// revert() without a reason
+		{"", "", errNoRevertReason},
+		// unknown selector
+		{"deadbeef" +
+			"0000000000000000000000000000000000000000000000000000000000000020" +
+			"0000000000000000000000000000000000000000000000000000000000000000", "", errNoRevertReason},
+		// length overflows the output
+		{"08c379a0" +
+			"0000000000000000000000000000000000000000000000000000000000000020" +
+			"0000000000000000000000000000000000000000000000000000000000000040" +
+			"6e6f706500000000000000000000000000000000000000000000000000000000", "", errNoRevertReason},
+		// offset overflows the output
+		{"08c379a0" +
+			"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff" +
+			"0000000000000000000000000000000000000000000000000000000000000004", "", errNoRevertReason},
+	}
+	for i, tt := range tests {
+		reason, err := UnpackRevertReason(common.Hex2Bytes(tt.output))
+		if err != tt.err {
+			t.Errorf("test %d: error mismatch: have %v, want %v", i, err, tt.err)
+		}
+		if reason != tt.reason {
+			t.Errorf("test %d: reason mismatch: have %q, want %q", i, reason, tt.reason)
+		}
+	}
+}
@@ -178,3 +178,119 @@ func (journal *txJournal) close() error {
 	}
 	return err
 }
+
+// requestJournal is a rotating log of the request transactions of the request
+// blocks to be mined, to allow them to survive node restarts.
+type requestJournal struct {
+	path   string         // Filesystem path to store the request transactions at
+	writer io.WriteCloser // Output stream to write new request transactions into
+}
+
+// newRequestJournal creates a new request transaction journal.
+func newRequestJournal(path string) *requestJournal {
+	return &requestJournal{
+		path: path,
+	}
+}
+
+// load parses a request transaction journal dump from disk, loading the
+// request blocks into the specified pool.
+func (journal *requestJournal) load(add func(*requestBlock) error) error {
+	// Skip the parsing if the journal file doesn't exist at all
+	if _, err := os.Stat(journal.path); os.IsNotExist(err) {
+		return nil
+	}
+	// Open the journal for loading any past request transactions
+	input, err := os.Open(journal.path)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	// Temporarily discard any journal additions (don't double add on load)
+	journal.writer = new(devNull)
+	defer func() { journal.writer = nil }()
+
+	// Inject all request transactions from the journal into the pool
+	stream := rlp.NewStream(input, 0)
+	total, dropped := 0, 0
+
+	var failure error
+	for {
+		block := new(requestBlock)
+		if err = stream.Decode(block); err != nil {
+			if err != io.EOF {
+				failure = err
+			}
+			break
+		}
+		total++
+		if err := add(block); err != nil {
+			log.Debug("Failed to add journaled request transactions", "err", err)
+			dropped++
+		}
+	}
+	log.Info("Loaded request transaction journal", "blocks", total, "dropped", dropped)
+
+	return failure
+}
+
+// insert adds a request block to the disk journal.
+func (journal *requestJournal) insert(block *requestBlock) error {
+	if journal.writer == nil {
+		return errNoActiveJournal
+	}
+	if err := rlp.Encode(journal.writer, block); err != nil {
+		return err
+	}
+	return nil
+}
+
+// rotate regenerates the request transaction journal based on the current
+// request blocks of the transaction pool.
+func (journal *requestJournal) rotate(all []*requestBlock) error {
+	// Close the current journal (if any is open)
+	if journal.writer != nil {
+		if err := journal.writer.Close(); err != nil {
+			return err
+		}
+		journal.writer = nil
+	}
+	// Generate a new journal with the contents of the current pool
+	replacement, err := os.OpenFile(journal.path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0755)
+	if err != nil {
+		return err
+	}
+	for _, block := range all {
+		if err = rlp.Encode(replacement, block); err != nil {
+			replacement.Close()
+			return err
+		}
+	}
+	replacement.Close()
+
+	// Replace the live journal with the newly generated one
+	if err = os.Rename(journal.path+".new", journal.path); err != nil {
+		return err
+	}
+	sink, err := os.OpenFile(journal.path, os.O_WRONLY|os.O_APPEND, 0755)
+	if err != nil {
+		return err
+	}
+	journal.writer = sink
+	log.Debug("Regenerated request transaction journal", "blocks", len(all))
+
+	return nil
+}
+
+// close flushes the request transaction journal contents to disk and closes
+// the file.
+func (journal *requestJournal) close() error {
+	var err error
+
+	if journal.writer != nil {
+		err = journal.writer.Close()
+		journal.writer = nil
+	}
+	return err
+}
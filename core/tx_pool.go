@@ -38,6 +38,10 @@ import (
 const (
 	// chainHeadChanSize is the size of channel listening to ChainHeadEvent.
 	chainHeadChanSize = 10
+
+	// maxRequestLookback is the maximum number of blocks searched back for mined
+	// request blocks to drop from the pool.
+	maxRequestLookback = 1024
 )
 
 var (
@@ -76,6 +80,18 @@ var (
 	// than some meaningful limit a user might use. This is not a consensus error
 	// making the transaction invalid, rather a DOS protection.
 	ErrOversizedData = errors.New("oversized data")
+
+	// ErrRequestSender is returned if a request transaction is not signed by the
+	// null address.
+	ErrRequestSender = errors.New("request transaction not from null address")
+
+	// ErrRequestGas is returned if a request transaction's gas limit exceeds the
+	// REQUEST_GAS of the RootChain contract.
+	ErrRequestGas = errors.New("exceeds request gas")
+
+	// ErrKnownRequests is returned if the request transactions of a block are
+	// already queued.
+	ErrKnownRequests = errors.New("known request transactions")
 )
 
 var (
@@ -128,6 +144,8 @@ type TxPoolConfig struct {
 	Journal   string           // Journal of local transactions to survive node restarts
 	Rejournal time.Duration    // Time interval to regenerate the local transaction journal
 
+	RequestJournal string // Journal of request transactions to survive node restarts (disabled if empty)
+
 	PriceLimit uint64 // Minimum gas price to enforce for acceptance into the pool
 	PriceBump  uint64 // Minimum price bump percentage to replace an already existing transaction (nonce)
 
@@ -201,14 +219,20 @@ type TxPool struct {
 	locals  *accountSet // Set of local transaction to exempt from eviction rules
 	journal *txJournal  // Journal of local transaction to back up to disk
 
-	pending     map[common.Address]*txList   // All currently processable transactions
-	queue       map[common.Address]*txList   // Queued but non-processable transactions
-	requestTxCh chan types.Transactions      // Queued request transactions
-	beats       map[common.Address]time.Time // Last heartbeat from each known account
-	all         *txLookup                    // All transactions to allow lookups
-	priced      *txPricedList                // All transactions sorted by price
+	pending map[common.Address]*txList   // All currently processable transactions
+	queue   map[common.Address]*txList   // Queued but non-processable transactions
+	beats   map[common.Address]time.Time // Last heartbeat from each known account
+	all     *txLookup                    // All transactions to allow lookups
+	priced  *txPricedList                // All transactions sorted by price
+
+	requests         []*requestBlock // Request blocks to be mined, in order
+	requestGas       uint64          // Gas limit of a request transaction
+	requestJournal   *requestJournal // Journal of request transactions to back up to disk
+	requestCh        chan struct{}   // Notification channel for new request transactions
+	lastRequestBlock common.Hash     // Last request block whose transactions are dropped from the pool
 
-	wg sync.WaitGroup // for shutdown sync
+	wg   sync.WaitGroup // for shutdown sync
+	quit chan struct{}  // Channel to unblock request retrievals on shutdown
 
 	homestead bool
 }
@@ -227,11 +251,13 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig, chain block
 		signer:      types.NewEIP155Signer(chainconfig.ChainID),
 		pending:     make(map[common.Address]*txList),
 		queue:       make(map[common.Address]*txList),
-		requestTxCh: make(chan types.Transactions),
 		beats:       make(map[common.Address]time.Time),
 		all:         newTxLookup(),
 		chainHeadCh: make(chan ChainHeadEvent, chainHeadChanSize),
 		gasPrice:    new(big.Int).SetUint64(config.PriceLimit),
+		requestGas:  params.RequestTxGasLimit,
+		requestCh:   make(chan struct{}, 1),
+		quit:        make(chan struct{}),
 	}
 	pool.locals = newAccountSet(pool.signer)
 	for _, addr := range config.Locals {
@@ -252,6 +278,22 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig, chain block
 			log.Warn("Failed to rotate transaction journal", "err", err)
 		}
 	}
+	// If request transaction journaling is enabled, load from disk
+	if config.RequestJournal != "" {
+		pool.requestJournal = newRequestJournal(config.RequestJournal)
+
+		if err := pool.requestJournal.load(pool.addRequestBlock); err != nil {
+			log.Warn("Failed to load request transaction journal", "err", err)
+		}
+		// The journal may hold request blocks mined right before a crash
+		pool.mu.Lock()
+		pool.dropMinedRequests(pool.chain.CurrentBlock(), 0)
+		pool.mu.Unlock()
+
+		if err := pool.requestJournal.rotate(pool.requests); err != nil {
+			log.Warn("Failed to rotate request transaction journal", "err", err)
+		}
+	}
 	// Subscribe events from blockchain
 	pool.chainHeadSub = pool.chain.SubscribeChainHeadEvent(pool.chainHeadCh)
 
@@ -366,6 +408,11 @@ func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 
 		if depth := uint64(math.Abs(float64(oldNum) - float64(newNum))); depth > 64 {
 			log.Debug("Skipping deep transaction reorg", "depth", depth)
+		} else if pool.chain.GetBlock(oldHead.Hash(), oldNum) == nil {
+			// This can happen if the chain is rewound by SetHead (e.g. on a
+			// rootchain fork), where the old head is discarded from the chain.
+			// The lost transactions are reinjected by the caller of SetHead.
+			log.Debug("Skipping transaction reorg of discarded head", "old", oldHead.Hash(), "oldnum", oldNum, "new", newHead.Hash(), "newnum", newNum)
 		} else {
 			// Reorg seems shallow enough to pull in all transactions into memory
 			var discarded, included types.Transactions
@@ -435,6 +482,16 @@ func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 	// Check the queue and move transactions over to the pending if possible
 	// or remove those that have become invalid
 	pool.promoteExecutables(nil)
+
+	// Drop the request transactions included in the new chain segment, which
+	// may span multiple blocks if head events were skipped
+	if block := pool.chain.GetBlock(newHead.Hash(), newHead.Number.Uint64()); block != nil {
+		var since uint64
+		if oldHead != nil && oldHead.Hash() == newHead.ParentHash {
+			since = oldHead.Number.Uint64()
+		}
+		pool.dropMinedRequests(block, since)
+	}
 }
 
 // Stop terminates the transaction pool.
@@ -445,10 +502,14 @@ func (pool *TxPool) Stop() {
 	// Unsubscribe subscriptions registered from blockchain
 	pool.chainHeadSub.Unsubscribe()
 	pool.wg.Wait()
+	close(pool.quit)
 
 	if pool.journal != nil {
 		pool.journal.close()
 	}
+	if pool.requestJournal != nil {
+		pool.requestJournal.close()
+	}
 	log.Info("Transaction pool stopped")
 }
 
@@ -549,9 +610,220 @@ func (pool *TxPool) Locals() []common.Address {
 	return pool.locals.flatten()
 }
 
-// Requests retrieves all request transactions
+// Requests retrieves the request transactions of the next request block to be
+// mined. It blocks until they are added to the pool, and returns nil if the pool
+// is stopped.
 func (pool *TxPool) Requests() types.Transactions {
-	return <-pool.requestTxCh
+	for {
+		pool.mu.Lock()
+		// The current block may be mined before the pool is reset to it
+		head := pool.chain.CurrentBlock()
+		pool.dropMinedRequests(head, head.NumberU64()-1)
+		if len(pool.requests) > 0 {
+			txs := make(types.Transactions, len(pool.requests[0].Transactions))
+			copy(txs, pool.requests[0].Transactions)
+			pool.mu.Unlock()
+			return txs
+		}
+		pool.mu.Unlock()
+
+		select {
+		case <-pool.requestCh:
+		case <-pool.quit:
+			return nil
+		}
+	}
+}
+
+// RequestStats retrieves the number of request blocks to be mined and the
+// number of their request transactions.
+func (pool *TxPool) RequestStats() (int, int) {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	txs := 0
+	for _, block := range pool.requests {
+		txs += len(block.Transactions)
+	}
+	return len(pool.requests), txs
+}
+
+// RequestContent retrieves the request transactions of the request blocks to be
+// mined, in order. The returned transaction set is a copy and can be freely
+// modified by calling code.
+func (pool *TxPool) RequestContent() []types.Transactions {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	content := make([]types.Transactions, len(pool.requests))
+	for i, block := range pool.requests {
+		content[i] = make(types.Transactions, len(block.Transactions))
+		copy(content[i], block.Transactions)
+	}
+	return content
+}
+
+// SetRequestGas updates the gas limit of request transactions, which is the
+// REQUEST_GAS of the RootChain contract.
+func (pool *TxPool) SetRequestGas(gas uint64) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	pool.requestGas = gas
+}
+
+// AddRequests validates the request transactions of a request block and queues
+// them to be mined after the ones already in the pool. The request block is
+// identified by its id on the RootChain contract and mined as block number.
+func (pool *TxPool) AddRequests(id uint64, number uint64, txs types.Transactions) error {
+	return pool.addRequestBlock(&requestBlock{Id: id, Number: number, Transactions: txs})
+}
+
+// addRequestBlock validates and queues a request block.
+func (pool *TxPool) addRequestBlock(block *requestBlock) error {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	for _, tx := range block.Transactions {
+		if err := pool.validateRequestTx(tx); err != nil {
+			log.Trace("Discarding invalid request transaction", "hash", tx.Hash(), "err", err)
+			invalidTxCounter.Inc(1)
+			return err
+		}
+	}
+	// Request transactions carry no request id, so different request blocks
+	// may have the same transactions
+	for _, queued := range pool.requests {
+		if queued.Id == block.Id {
+			return ErrKnownRequests
+		}
+	}
+	pool.requests = append(pool.requests, block)
+
+	if pool.requestJournal != nil {
+		if err := pool.requestJournal.insert(block); err != nil {
+			log.Warn("Failed to journal request transactions", "err", err)
+		}
+	}
+	log.Trace("Pooled new request transactions", "id", block.Id, "number", block.Number, "count", len(block.Transactions), "queued", len(pool.requests))
+
+	select {
+	case pool.requestCh <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// ResetRequests drops all request transactions, e.g. when the chain is rewound
+// and the request blocks are prepared again.
+func (pool *TxPool) ResetRequests() {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	pool.requests = nil
+	pool.lastRequestBlock = common.Hash{}
+	pool.rotateRequestJournal()
+}
+
+// validateRequestTx checks whether a request transaction is signed by the null
+// address and its gas limit doesn't exceed the request gas.
+func (pool *TxPool) validateRequestTx(tx *types.Transaction) error {
+	if tx.Value().Sign() < 0 {
+		return ErrNegativeValue
+	}
+	from, err := types.Sender(pool.signer, tx)
+	if err != nil {
+		return ErrInvalidSender
+	}
+	if from != params.NullAddress {
+		return ErrRequestSender
+	}
+	if tx.Gas() > pool.requestGas {
+		return ErrRequestGas
+	}
+	return nil
+}
+
+// dropMinedRequests drops the request transactions included in the chain up to
+// head. Request blocks are mined in the order they are queued, so the mined ones
+// are the front of the queue and the most recent request blocks of the chain.
+// A mined block is matched to the request block queued for its number.
+// The chain is searched back to the block after since, the last request block
+// dropped or at most maxRequestLookback blocks, whichever comes first.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *TxPool) dropMinedRequests(head *types.Block, since uint64) {
+	if len(pool.requests) == 0 || head.Hash() == pool.lastRequestBlock {
+		return
+	}
+	if head.NumberU64() > maxRequestLookback && since < head.NumberU64()-maxRequestLookback {
+		since = head.NumberU64() - maxRequestLookback
+	}
+	// Collect the most recent request blocks, newest first
+	var mined []*types.Block
+	for block := head; block != nil && block.NumberU64() > since && len(mined) < len(pool.requests); {
+		if block.Hash() == pool.lastRequestBlock {
+			break
+		}
+		if block.IsRequest() {
+			mined = append(mined, block)
+		}
+		block = pool.chain.GetBlock(block.ParentHash(), block.NumberU64()-1)
+	}
+	// Find the longest front of the queue mined by those blocks
+	for n := len(mined); n > 0; n-- {
+		matched := true
+		for i := 0; i < n && matched; i++ {
+			matched = pool.requests[i].minedBy(mined[n-1-i])
+		}
+		if !matched {
+			continue
+		}
+		pool.requests = pool.requests[n:]
+		pool.lastRequestBlock = mined[0].Hash()
+		pool.rotateRequestJournal()
+
+		log.Trace("Dropped mined request transactions", "blocks", n, "number", mined[0].Number(), "hash", mined[0].Hash(), "queued", len(pool.requests))
+		return
+	}
+}
+
+// rotateRequestJournal regenerates the request transaction journal, if enabled.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *TxPool) rotateRequestJournal() {
+	if pool.requestJournal == nil {
+		return
+	}
+	if err := pool.requestJournal.rotate(pool.requests); err != nil {
+		log.Warn("Failed to rotate request transaction journal", "err", err)
+	}
+}
+
+// requestBlock is a request block to be mined, identified by its request block
+// id on the RootChain contract.
+type requestBlock struct {
+	Id           uint64             // Request block id on the RootChain contract
+	Number       uint64             // Number of the block to mine the requests in
+	Transactions types.Transactions // Request transactions of the block
+}
+
+// minedBy reports whether the request block is mined by the given block.
+func (rb *requestBlock) minedBy(block *types.Block) bool {
+	return block.NumberU64() == rb.Number && sameTransactions(rb.Transactions, block.Transactions())
+}
+
+// sameTransactions reports whether the two transaction lists are the same.
+func sameTransactions(a, b types.Transactions) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Hash() != b[i].Hash() {
+			return false
+		}
+	}
+	return true
 }
 
 // local retrieves all currently known local transactions, grouped by origin
@@ -728,13 +1000,6 @@ func (pool *TxPool) enqueueTx(hash common.Hash, tx *types.Transaction) (bool, er
 	return old != nil, nil
 }
 
-func (pool *TxPool) EnqueueReqeustTxs(rtxs types.Transactions) (bool, error) {
-	// TODO: validate all txs are from null address
-	pool.requestTxCh <- rtxs
-
-	return true, nil
-}
-
 // journalTx adds the specified transaction to the local disk journal if it is
 // deemed to have been sent from a local account.
 func (pool *TxPool) journalTx(from common.Address, tx *types.Transaction) {
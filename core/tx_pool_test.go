@@ -42,6 +42,7 @@ var testTxPoolConfig TxPoolConfig
 func init() {
 	testTxPoolConfig = DefaultTxPoolConfig
 	testTxPoolConfig.Journal = ""
+	testTxPoolConfig.RequestJournal = ""
 }
 
 type testBlockChain struct {
@@ -126,7 +127,7 @@ func validateEvents(events chan NewTxsEvent, count int) error {
 		case ev := <-events:
 			received = append(received, ev.Txs...)
 		case <-time.After(time.Second):
-			return fmt.Errorf("event #%d not fired", received)
+			return fmt.Errorf("event #%d not fired", len(received))
 		}
 	}
 	if len(received) > count {
@@ -1736,6 +1737,216 @@ func TestTransactionStatusCheck(t *testing.T) {
 	}
 }
 
+// Tests that request transactions are validated, deduplicated and handed out in
+// order, and dropped from the pool once their request block is mined.
+func TestRequestTransactions(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool()
+	defer pool.Stop()
+
+	first := requestTransactions(2, 0)
+	second := requestTransactions(1, 1)
+
+	if err := pool.AddRequests(0, 1, first); err != nil {
+		t.Fatalf("failed to add requests: %v", err)
+	}
+	if err := pool.AddRequests(1, 2, second); err != nil {
+		t.Fatalf("failed to add requests: %v", err)
+	}
+	if err := pool.AddRequests(0, 1, first); err != ErrKnownRequests {
+		t.Errorf("known requests error mismatch: have %v, want %v", err, ErrKnownRequests)
+	}
+	// Another request block with the same requests is still queued
+	if err := pool.AddRequests(2, 3, second); err != nil {
+		t.Fatalf("failed to add identical requests: %v", err)
+	}
+	if err := pool.AddRequests(3, 4, types.Transactions{transaction(0, 100000, key)}); err != ErrRequestSender {
+		t.Errorf("request sender error mismatch: have %v, want %v", err, ErrRequestSender)
+	}
+	if err := pool.AddRequests(3, 4, types.Transactions{types.NewTransaction(0, common.Address{}, big.NewInt(0), params.RequestTxGasLimit+1, params.RequestTxGasPrice, nil)}); err != ErrRequestGas {
+		t.Errorf("request gas error mismatch: have %v, want %v", err, ErrRequestGas)
+	}
+	if blocks, txs := pool.RequestStats(); blocks != 3 || txs != 4 {
+		t.Fatalf("request stats mismatch: have %d blocks, %d txs, want 3 blocks, 4 txs", blocks, txs)
+	}
+	if requests := pool.Requests(); !sameTransactions(requests, first) {
+		t.Fatalf("first requests mismatch: have %v, want %v", requests, first)
+	}
+	// Mining a non-request block keeps the requests
+	pool.mu.Lock()
+	pool.dropMinedRequests(types.NewBlock(&types.Header{Number: big.NewInt(1)}, types.Transactions{transaction(0, 100000, key)}, nil, nil), 0)
+	pool.mu.Unlock()
+
+	if requests := pool.Requests(); !sameTransactions(requests, first) {
+		t.Fatalf("requests dropped by user block: have %v, want %v", requests, first)
+	}
+	// Mining the request block moves to the next requests
+	pool.mu.Lock()
+	pool.dropMinedRequests(types.NewBlock(&types.Header{Number: big.NewInt(1)}, first, nil, nil), 0)
+	pool.mu.Unlock()
+
+	if requests := pool.Requests(); !sameTransactions(requests, second) {
+		t.Fatalf("second requests mismatch: have %v, want %v", requests, second)
+	}
+	// Mining the second request block keeps the identical third one
+	pool.mu.Lock()
+	pool.dropMinedRequests(types.NewBlock(&types.Header{Number: big.NewInt(2)}, second, nil, nil), 0)
+	pool.mu.Unlock()
+
+	if blocks, txs := pool.RequestStats(); blocks != 1 || txs != 1 {
+		t.Fatalf("identical requests dropped: have %d blocks, %d txs, want 1 block, 1 tx", blocks, txs)
+	}
+	pool.ResetRequests()
+	if blocks, txs := pool.RequestStats(); blocks != 0 || txs != 0 {
+		t.Fatalf("requests not reset: have %d blocks, %d txs", blocks, txs)
+	}
+}
+
+// Tests that pending request transactions survive node restarts.
+func TestRequestJournaling(t *testing.T) {
+	t.Parallel()
+
+	// Create a temporary file for the journal
+	file, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("failed to create temporary journal: %v", err)
+	}
+	journal := file.Name()
+	defer os.Remove(journal)
+
+	// Clean up the temporary file, we only need the path for now
+	file.Close()
+	os.Remove(journal)
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(ethdb.NewMemDatabase()))
+	blockchain := &testBlockChain{statedb, 1000000, new(event.Feed)}
+
+	config := testTxPoolConfig
+	config.RequestJournal = journal
+
+	pool := NewTxPool(config, params.TestChainConfig, blockchain)
+
+	first := requestTransactions(2, 0)
+	second := requestTransactions(1, 1)
+	if err := pool.AddRequests(0, 1, first); err != nil {
+		t.Fatalf("failed to add requests: %v", err)
+	}
+	if err := pool.AddRequests(1, 2, second); err != nil {
+		t.Fatalf("failed to add requests: %v", err)
+	}
+	pool.Stop()
+
+	// Restart the pool and ensure the requests were loaded in order
+	pool = NewTxPool(config, params.TestChainConfig, blockchain)
+
+	content := pool.RequestContent()
+	if len(content) != 2 || !sameTransactions(content[0], first) || !sameTransactions(content[1], second) {
+		t.Fatalf("journaled requests mismatch: have %v, want %v", content, []types.Transactions{first, second})
+	}
+	// Mine the first request block and ensure only the rest is journaled
+	pool.mu.Lock()
+	pool.dropMinedRequests(types.NewBlock(&types.Header{Number: big.NewInt(1)}, first, nil, nil), 0)
+	pool.mu.Unlock()
+	pool.Stop()
+
+	pool = NewTxPool(config, params.TestChainConfig, blockchain)
+	defer pool.Stop()
+
+	content = pool.RequestContent()
+	if len(content) != 1 || !sameTransactions(content[0], second) {
+		t.Fatalf("journaled requests mismatch after mining: have %v, want %v", content, []types.Transactions{second})
+	}
+}
+
+// testRequestChain is a test blockchain made of the given blocks, the last one
+// being the head.
+type testRequestChain struct {
+	*testBlockChain
+	blocks []*types.Block
+}
+
+func (bc *testRequestChain) CurrentBlock() *types.Block {
+	return bc.blocks[len(bc.blocks)-1]
+}
+
+func (bc *testRequestChain) GetBlock(hash common.Hash, number uint64) *types.Block {
+	if number < uint64(len(bc.blocks)) && bc.blocks[number].Hash() == hash {
+		return bc.blocks[number]
+	}
+	return nil
+}
+
+// Tests that request blocks mined before a restart, e.g. right before a crash
+// or without a head event for each of them, are not replayed from the journal.
+func TestRequestJournalMined(t *testing.T) {
+	t.Parallel()
+
+	file, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("failed to create temporary journal: %v", err)
+	}
+	journal := file.Name()
+	defer os.Remove(journal)
+
+	file.Close()
+	os.Remove(journal)
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(ethdb.NewMemDatabase()))
+	blockchain := &testRequestChain{
+		testBlockChain: &testBlockChain{statedb, 1000000, new(event.Feed)},
+		blocks:         []*types.Block{types.NewBlock(&types.Header{Number: big.NewInt(0)}, nil, nil, nil)},
+	}
+	config := testTxPoolConfig
+	config.RequestJournal = journal
+
+	pool := NewTxPool(config, params.TestChainConfig, blockchain)
+
+	first := requestTransactions(2, 0)
+	second := requestTransactions(1, 1)
+	third := requestTransactions(1, 2)
+	for i, requests := range []types.Transactions{first, second, third} {
+		if err := pool.AddRequests(uint64(i), []uint64{1, 3, 4}[i], requests); err != nil {
+			t.Fatalf("failed to add requests: %v", err)
+		}
+	}
+	pool.Stop()
+
+	// Mine the first two request blocks with a user block in between
+	key, _ := crypto.GenerateKey()
+	for _, txs := range []types.Transactions{first, {transaction(0, 100000, key)}, second} {
+		parent := blockchain.CurrentBlock()
+		blockchain.blocks = append(blockchain.blocks, types.NewBlock(&types.Header{
+			ParentHash: parent.Hash(),
+			Number:     new(big.Int).Add(parent.Number(), common.Big1),
+		}, txs, nil, nil))
+	}
+	pool = NewTxPool(config, params.TestChainConfig, blockchain)
+
+	content := pool.RequestContent()
+	if len(content) != 1 || !sameTransactions(content[0], third) {
+		t.Fatalf("mined requests replayed: have %v, want %v", content, []types.Transactions{third})
+	}
+	pool.Stop()
+
+	pool = NewTxPool(config, params.TestChainConfig, blockchain)
+	defer pool.Stop()
+
+	if content := pool.RequestContent(); len(content) != 1 || !sameTransactions(content[0], third) {
+		t.Fatalf("mined requests still journaled: have %v, want %v", content, []types.Transactions{third})
+	}
+}
+
+// requestTransactions creates a body of request transactions, which are not
+// signed and so are sent by the null address.
+func requestTransactions(n int, value int64) types.Transactions {
+	txs := make(types.Transactions, n)
+	for i := range txs {
+		txs[i] = types.NewTransaction(0, common.BytesToAddress([]byte{byte(i + 1)}), big.NewInt(value), params.RequestTxGasLimit, params.RequestTxGasPrice, nil)
+	}
+	return txs
+}
+
 // Benchmarks the speed of validating the contents of the pending queue of the
 // transaction pool.
 func BenchmarkPendingDemotion100(b *testing.B)   { benchmarkPendingDemotion(b, 100) }
@@ -41,7 +41,12 @@ func DeriveSha(list DerivableList) common.Hash {
 	return trie.Hash()
 }
 
+// DeriveShaFromBMT returns the binary Merkle root of the list. The root of an
+// empty list is EmptyRootHash, the same as NewBlock sets for empty blocks.
 func DeriveShaFromBMT(list DerivableList) common.Hash {
+	if list.Len() == 0 {
+		return EmptyRootHash
+	}
 	var level []common.Hash
 	for i := 0; i < list.Len(); i++ {
 		level = append(level, crypto.Keccak256Hash(list.GetRlp(i)))
@@ -94,3 +94,9 @@ func TestCheckMembership(t *testing.T) {
 		t.Fatal("both hash should be equal, but they aren't", cH, r)
 	}
 }
+
+func TestDeriveShaFromBMTEmpty(t *testing.T) {
+	if root := DeriveShaFromBMT(Transactions{}); root != EmptyRootHash {
+		t.Fatalf("empty list root mismatch: have %x, want %x", root, EmptyRootHash)
+	}
+}
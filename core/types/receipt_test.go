@@ -0,0 +1,67 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/rlp"
+)
+
+// Tests that the revert reason survives the storage encoding and that receipts
+// stored without one still decode.
+func TestReceiptStorageRevertReason(t *testing.T) {
+	receipt := &Receipt{
+		Status:            ReceiptStatusFailed,
+		CumulativeGasUsed: 21000,
+		Logs:              []*Log{},
+		TxHash:            common.HexToHash("0x01"),
+		GasUsed:           21000,
+		RevertReason:      "nope",
+	}
+	enc, err := rlp.EncodeToBytes((*ReceiptForStorage)(receipt))
+	if err != nil {
+		t.Fatalf("failed to encode receipt: %v", err)
+	}
+	dec := new(ReceiptForStorage)
+	if err := rlp.DecodeBytes(enc, dec); err != nil {
+		t.Fatalf("failed to decode receipt: %v", err)
+	}
+	if !reflect.DeepEqual((*Receipt)(dec), receipt) {
+		t.Fatalf("receipt mismatch: have %+v, want %+v", dec, receipt)
+	}
+
+	legacy, err := rlp.EncodeToBytes(&legacyReceiptStorageRLP{
+		PostStateOrStatus: receiptStatusFailedRLP,
+		CumulativeGasUsed: receipt.CumulativeGasUsed,
+		TxHash:            receipt.TxHash,
+		Logs:              []*LogForStorage{},
+		GasUsed:           receipt.GasUsed,
+	})
+	if err != nil {
+		t.Fatalf("failed to encode legacy receipt: %v", err)
+	}
+	dec = new(ReceiptForStorage)
+	if err := rlp.DecodeBytes(legacy, dec); err != nil {
+		t.Fatalf("failed to decode legacy receipt: %v", err)
+	}
+	if dec.RevertReason != "" || dec.TxHash != receipt.TxHash || dec.GasUsed != receipt.GasUsed {
+		t.Fatalf("legacy receipt mismatch: have %+v", dec)
+	}
+}
@@ -4448,6 +4448,12 @@ var _bundleJs = []byte((((((((((`!function(modules) {
             title: "System",
             icon: "tachometer"
         }
+    }, {
+        id: "plasma",
+        menu: {
+            title: "Plasma",
+            icon: "cubes"
+        }
     }, {
         id: "logs",
         menu: {
@@ -27900,6 +27906,12 @@ var _bundleJs = []byte((((((((((`!function(modules) {
                 endBottom: !0,
                 topChanged: 0,
                 bottomChanged: 0
+            },
+            plasma: {
+                epochs: [],
+                submissions: [],
+                invalidExits: [],
+                requests: []
             }
         };
     }, updaters = {
@@ -27921,7 +27933,13 @@ var _bundleJs = []byte((((((((((`!function(modules) {
             diskRead: appender(200),
             diskWrite: appender(200)
         },
-        logs: (0, _Logs.inserter)(5)
+        logs: (0, _Logs.inserter)(5),
+        plasma: {
+            epochs: replacer,
+            submissions: replacer,
+            invalidExits: replacer,
+            requests: appender(200)
+        }
     }, styles = {
         dashboard: {
             display: "flex",
@@ -32419,7 +32437,7 @@ var _bundleJs = []byte((((((((((`!function(modules) {
             return protoProps && defineProperties(Constructor.prototype, protoProps), staticProps && defineProperties(Constructor, staticProps), 
             Constructor;
         };
-    }(), _react = __webpack_require__(0), _react2 = _interopRequireDefault(_react), _withStyles = __webpack_require__(10), _withStyles2 = _interopRequireDefault(_withStyles), _common = __webpack_require__(81), _Logs = __webpack_require__(261), _Logs2 = _interopRequireDefault(_Logs), _Footer = __webpack_require__(551), _Footer2 = _interopRequireDefault(_Footer), styles = {
+    }(), _react = __webpack_require__(0), _react2 = _interopRequireDefault(_react), _withStyles = __webpack_require__(10), _withStyles2 = _interopRequireDefault(_withStyles), _common = __webpack_require__(81), _Logs = __webpack_require__(261), _Logs2 = _interopRequireDefault(_Logs), _Plasma = __webpack_require__(949), _Plasma2 = _interopRequireDefault(_Plasma), _Footer = __webpack_require__(551), _Footer2 = _interopRequireDefault(_Footer), styles = {
         wrapper: {
             display: "flex",
             flexDirection: "column",
@@ -32468,6 +32486,13 @@ var _bundleJs = []byte((((((((((`!function(modules) {
                     children = _react2.default.createElement("div", null, "Work in progress.");
                     break;
 
+                  case _common.MENU.get("plasma").id:
+                    children = _react2.default.createElement(_Plasma2.default, {
+                        content: content.plasma,
+                        shouldUpdate: shouldUpdate
+                    });
+                    break;
+
                   case _common.MENU.get("logs").id:
                     children = _react2.default.createElement(_Logs2.default, {
                         ref: function(_ref) {
@@ -40549,7 +40574,7 @@ var _bundleJs = []byte((((((((((`!function(modules) {
     }
     Object.defineProperty(exports, "__esModule", {
         value: !0
-    }), exports.bytePerSecPlotter = exports.bytePlotter = exports.percentPlotter = exports.multiplier = void 0;
+    }), exports.bytePerSecPlotter = exports.bytePlotter = exports.ratePlotter = exports.percentPlotter = exports.multiplier = void 0;
     var _createClass = function() {
         function defineProperties(target, props) {
             for (var i = 0; i < props.length; i++) {
@@ -40578,6 +40603,17 @@ var _bundleJs = []byte((((((((((`!function(modules) {
                 style: _common.styles.light
             }, text), " ", p.toFixed(2), " %");
         };
+    }, exports.ratePlotter = function(text) {
+        var mapper = arguments.length > 1 && void 0 !== arguments[1] ? arguments[1] : multiplier(1);
+        return function(payload) {
+            var p = mapper(payload);
+            return "number" != typeof p ? null : _react2.default.createElement(_Typography2.default, {
+                type: "caption",
+                color: "inherit"
+            }, _react2.default.createElement("span", {
+                style: _common.styles.light
+            }, text), " ", p.toFixed(2), " /s");
+        };
     }, [ "", "Ki", "Mi", "Gi", "Ti", "Pi", "Ei", "Zi", "Yi" ]), simplifyBytes = function(x) {
         for (var i = 0; x > 1024 && i < 8; i++) x /= 1024;
         return x.toFixed(2).toString().concat(" ", unit[i], "B");
@@ -40616,6 +40652,209 @@ var _bundleJs = []byte((((((((((`!function(modules) {
         } ]), CustomTooltip;
     }(_react.Component));
     exports.default = CustomTooltip;
+}, function(module, exports, __webpack_require__) {
+    "use strict";
+    function _interopRequireDefault(obj) {
+        return obj && obj.__esModule ? obj : {
+            default: obj
+        };
+    }
+    function _classCallCheck(instance, Constructor) {
+        if (!(instance instanceof Constructor)) throw new TypeError("Cannot call a class as a function");
+    }
+    function _possibleConstructorReturn(self, call) {
+        if (!self) throw new ReferenceError("this hasn't been initialised - super() hasn't been called");
+        return !call || "object" != typeof call && "function" != typeof call ? self : call;
+    }
+    function _inherits(subClass, superClass) {
+        if ("function" != typeof superClass && null !== superClass) throw new TypeError("Super expression must either be null or a function, not " + typeof superClass);
+        subClass.prototype = Object.create(superClass && superClass.prototype, {
+            constructor: {
+                value: subClass,
+                enumerable: !1,
+                writable: !0, 
+                configurable: !0
+            }
+        }), superClass && (Object.setPrototypeOf ? Object.setPrototypeOf(subClass, superClass) : subClass.__proto__ = superClass);
+    }
+    Object.defineProperty(exports, "__esModule", {
+        value: !0
+    });
+    var _extends = Object.assign || function(target) {
+        for (var i = 1; i < arguments.length; i++) {
+            var source = arguments[i];
+            for (var key in source) Object.prototype.hasOwnProperty.call(source, key) && (target[key] = source[key]);
+        }
+        return target;
+    }, _createClass = function() {
+        function defineProperties(target, props) {
+            for (var i = 0; i < props.length; i++) {
+                var descriptor = props[i];
+                descriptor.enumerable = descriptor.enumerable || !1, descriptor.configurable = !0, 
+                "value" in descriptor && (descriptor.writable = !0), Object.defineProperty(target, descriptor.key, descriptor);
+            }
+        }
+        return function(Constructor, protoProps, staticProps) {
+            return protoProps && defineProperties(Constructor.prototype, protoProps), staticProps && defineProperties(Constructor, staticProps), 
+            Constructor;
+        };
+    }(), _react = __webpack_require__(0), _react2 = _interopRequireDefault(_react), _Typography = __webpack_require__(113), _Typography2 = _interopRequireDefault(_Typography), _recharts = __webpack_require__(571), _CustomTooltip = __webpack_require__(948), _CustomTooltip2 = _interopRequireDefault(_CustomTooltip), styles = {
+        section: {
+            marginBottom: 24
+        },
+        chart: {
+            height: 120
+        },
+        table: {
+            width: "100%",
+            borderCollapse: "collapse",
+            color: "white",
+            fontSize: 13
+        },
+        head: {
+            padding: "4px 8px",
+            textAlign: "left",
+            fontWeight: "normal",
+            color: "rgba(255, 255, 255, 0.54)",
+            borderBottom: "1px solid rgba(255, 255, 255, 0.12)"
+        },
+        cell: {
+            padding: "4px 8px",
+            borderBottom: "1px solid rgba(255, 255, 255, 0.12)"
+        },
+        hash: {
+            padding: "4px 8px",
+            borderBottom: "1px solid rgba(255, 255, 255, 0.12)",
+            fontFamily: "monospace"
+        }
+    }, epochColors = {
+        NRE: "#8884d8",
+        ORE: "#82ca9d",
+        URE: "#ffc658"
+    }, epochStatus = function(epoch) {
+        return epoch.finalized ? "finalized" : epoch.submitted ? "submitted" : "prepared";
+    }, shortHash = function(hash) {
+        return hash ? hash.substring(0, 10) + "…" + hash.substring(hash.length - 8) : "-";
+    }, header = function(titles) {
+        return _react2.default.createElement("thead", null, _react2.default.createElement("tr", null, titles.map(function(title) {
+            return _react2.default.createElement("th", {
+                key: title,
+                style: styles.head
+            }, title);
+        })));
+    }, Plasma = function(_Component) {
+        function Plasma() {
+            var _ref, _temp, _this, _ret;
+            _classCallCheck(this, Plasma);
+            for (var _len = arguments.length, args = Array(_len), _key = 0; _key < _len; _key++) args[_key] = arguments[_key];
+            return _temp = _this = _possibleConstructorReturn(this, (_ref = Plasma.__proto__ || Object.getPrototypeOf(Plasma)).call.apply(_ref, [ this ].concat(args))), 
+            _this.epochs = function() {
+                return _react2.default.createElement("div", {
+                    style: styles.section
+                }, _react2.default.createElement(_Typography2.default, {
+                    type: "title"
+                }, "Epochs"), _react2.default.createElement("table", {
+                    style: styles.table
+                }, header([ "Epoch", "Type", "Blocks", "Status" ]), _react2.default.createElement("tbody", null, _this.props.content.epochs.slice().reverse().map(function(epoch) {
+                    return _react2.default.createElement("tr", {
+                        key: epoch.fork + "-" + epoch.number
+                    }, _react2.default.createElement("td", {
+                        style: styles.cell
+                    }, epoch.fork, "/", epoch.number), _react2.default.createElement("td", {
+                        style: _extends({}, styles.cell, {
+                            color: epochColors[epoch.type]
+                        })
+                    }, epoch.type), _react2.default.createElement("td", {
+                        style: styles.cell
+                    }, epoch.empty ? "empty" : "#" + epoch.startBlock + " - #" + epoch.endBlock), _react2.default.createElement("td", {
+                        style: styles.cell
+                    }, epochStatus(epoch)));
+                }))));
+            }, _this.submissions = function() {
+                return _react2.default.createElement("div", {
+                    style: styles.section
+                }, _react2.default.createElement(_Typography2.default, {
+                    type: "title"
+                }, "Submissions"), _react2.default.createElement("table", {
+                    style: styles.table
+                }, header([ "Block", "Method", "Transaction", "Gas used", "Status" ]), _react2.default.createElement("tbody", null, _this.props.content.submissions.slice().reverse().map(function(sub) {
+                    return _react2.default.createElement("tr", {
+                        key: sub.txHash
+                    }, _react2.default.createElement("td", {
+                        style: styles.cell
+                    }, "#", sub.block), _react2.default.createElement("td", {
+                        style: styles.cell
+                    }, sub.method), _react2.default.createElement("td", {
+                        style: styles.hash
+                    }, shortHash(sub.txHash)), _react2.default.createElement("td", {
+                        style: styles.cell
+                    }, sub.gasUsed), _react2.default.createElement("td", {
+                        style: styles.cell
+                    }, sub.status));
+                }))));
+            }, _this.invalidExits = function() {
+                return _react2.default.createElement("div", {
+                    style: styles.section
+                }, _react2.default.createElement(_Typography2.default, {
+                    type: "title"
+                }, "Invalid exits"), _react2.default.createElement("table", {
+                    style: styles.table
+                }, header([ "Block", "Transaction", "Reason", "Challenge" ]), _react2.default.createElement("tbody", null, _this.props.content.invalidExits.map(function(exit) {
+                    return _react2.default.createElement("tr", {
+                        key: exit.txHash
+                    }, _react2.default.createElement("td", {
+                        style: styles.cell
+                    }, exit.fork, "/#", exit.block), _react2.default.createElement("td", {
+                        style: styles.hash
+                    }, shortHash(exit.txHash)), _react2.default.createElement("td", {
+                        style: styles.cell
+                    }, exit.reason || "-"), _react2.default.createElement("td", {
+                        style: styles.hash
+                    }, shortHash(exit.challengeTx)));
+                }))));
+            }, _this.requests = function() {
+                return _react2.default.createElement("div", {
+                    style: styles.section
+                }, _react2.default.createElement(_Typography2.default, {
+                    type: "title"
+                }, "Request throughput"), _react2.default.createElement("div", {
+                    style: styles.chart
+                }, _react2.default.createElement(_recharts.ResponsiveContainer, {
+                    width: "100%",
+                    height: "100%"
+                }, _react2.default.createElement(_recharts.AreaChart, {
+                    data: _this.props.content.requests.map(function(_ref2) {
+                        return {
+                            requests: _ref2.value || 0
+                        };
+                    })
+                }, _react2.default.createElement(_recharts.Tooltip, {
+                    cursor: !1,
+                    content: _react2.default.createElement(_CustomTooltip2.default, {
+                        tooltip: (0, _CustomTooltip.ratePlotter)("Requests")
+                    })
+                }), _react2.default.createElement(_recharts.Area, {
+                    isAnimationActive: !1,
+                    type: "monotone",
+                    dataKey: "requests",
+                    stroke: "#82ca9d",
+                    fill: "#82ca9d"
+                })))));
+            }, _ret = _temp, _possibleConstructorReturn(_this, _ret);
+        }
+        return _inherits(Plasma, _Component), _createClass(Plasma, [ {
+            key: "shouldComponentUpdate",
+            value: function(nextProps) {
+                return void 0 !== nextProps.shouldUpdate.plasma;
+            }
+        }, {
+            key: "render",
+            value: function() {
+                return _react2.default.createElement("div", null, this.requests(), this.epochs(), this.submissions(), this.invalidExits());
+            }
+        } ]), Plasma;
+    }(_react.Component);
+    exports.default = Plasma;
 } ]);`)))))))))))
 
 func bundleJsBytes() ([]byte, error) {
@@ -40629,7 +40868,7 @@ func bundleJs() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "bundle.js", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xef, 0x26, 0x2b, 0x98, 0x2d, 0xce, 0x9a, 0x8f, 0x9a, 0x5e, 0x37, 0x64, 0x5c, 0x70, 0xa2, 0xeb, 0x44, 0xb1, 0x6d, 0x8b, 0x8e, 0x95, 0x34, 0x14, 0x7a, 0x79, 0x4f, 0x8, 0xc3, 0xb3, 0x5, 0x3}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8, 0x8a, 0xf2, 0x6b, 0xdb, 0x8e, 0xf, 0x32, 0xf7, 0x17, 0xc0, 0xe2, 0x65, 0x73, 0xfb, 0x29, 0x96, 0x1, 0xa8, 0xf6, 0x1c, 0x67, 0xda, 0xd2, 0x34, 0xb0, 0x42, 0x92, 0xac, 0x43, 0x9, 0x1}}
 	return a, nil
 }
 
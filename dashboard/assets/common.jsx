@@ -48,6 +48,12 @@ const menuSkeletons: Array<{|id: string, menu: ProvidedMenuProp|}> = [
 			title: 'System',
 			icon:  'tachometer',
 		},
+	}, {
+		id:   'plasma',
+		menu: {
+			title: 'Plasma',
+			icon:  'cubes',
+		},
 	}, {
 		id:   'logs',
 		menu: {
@@ -37,6 +37,19 @@ export const percentPlotter = <T>(text: string, mapper: (T => T) = multiplier(1)
 	);
 };
 
+// ratePlotter renders a tooltip, which displays the value of the payload as a rate per second.
+export const ratePlotter = <T>(text: string, mapper: (T => T) = multiplier(1)) => (payload: T) => {
+	const p = mapper(payload);
+	if (typeof p !== 'number') {
+		return null;
+	}
+	return (
+		<Typography type='caption' color='inherit'>
+			<span style={styles.light}>{text}</span> {p.toFixed(2)} /s
+		</Typography>
+	);
+};
+
 // unit contains the units for the bytePlotter.
 const unit = ['', 'Ki', 'Mi', 'Gi', 'Ti', 'Pi', 'Ei', 'Zi', 'Yi'];
 
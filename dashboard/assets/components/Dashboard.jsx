@@ -106,6 +106,12 @@ const defaultContent: () => Content = () => ({
 		topChanged:    0,
 		bottomChanged: 0,
 	},
+	plasma: {
+		epochs:       [],
+		submissions:  [],
+		invalidExits: [],
+		requests:     [],
+	},
 });
 
 // updaters contains the state updater functions for each path of the state.
@@ -131,6 +137,12 @@ const updaters = {
 		diskWrite:      appender(200),
 	},
 	logs: logInserter(5),
+	plasma: {
+		epochs:       replacer,
+		submissions:  replacer,
+		invalidExits: replacer,
+		requests:     appender(200),
+	},
 };
 
 // styles contains the constant styles of the component.
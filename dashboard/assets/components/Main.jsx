@@ -22,6 +22,7 @@ import withStyles from 'material-ui/styles/withStyles';
 
 import {MENU} from '../common';
 import Logs from './Logs';
+import Plasma from './Plasma';
 import Footer from './Footer';
 import type {Content} from '../types/content';
 
@@ -95,6 +96,14 @@ class Main extends Component<Props> {
 		case MENU.get('system').id:
 			children = <div>Work in progress.</div>;
 			break;
+		case MENU.get('plasma').id:
+			children = (
+				<Plasma
+					content={content.plasma}
+					shouldUpdate={shouldUpdate}
+				/>
+			);
+			break;
 		case MENU.get('logs').id:
 			children = (
 				<Logs
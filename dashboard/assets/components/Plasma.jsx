@@ -0,0 +1,185 @@
+// @flow
+
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+import React, {Component} from 'react';
+
+import Typography from 'material-ui/Typography';
+import {ResponsiveContainer, AreaChart, Area, Tooltip} from 'recharts';
+
+import CustomTooltip, {ratePlotter} from './CustomTooltip';
+import type {Plasma as PlasmaContent} from '../types/content';
+
+// styles contains the constant styles of the component.
+const styles = {
+	section: {
+		marginBottom: 24,
+	},
+	chart: {
+		height: 120,
+	},
+	table: {
+		width:          '100%',
+		borderCollapse: 'collapse',
+		color:          'white',
+		fontSize:       13,
+	},
+	head: {
+		padding:      '4px 8px',
+		textAlign:    'left',
+		fontWeight:   'normal',
+		color:        'rgba(255, 255, 255, 0.54)',
+		borderBottom: '1px solid rgba(255, 255, 255, 0.12)',
+	},
+	cell: {
+		padding:      '4px 8px',
+		borderBottom: '1px solid rgba(255, 255, 255, 0.12)',
+	},
+	hash: {
+		padding:      '4px 8px',
+		borderBottom: '1px solid rgba(255, 255, 255, 0.12)',
+		fontFamily:   'monospace',
+	},
+};
+
+// epochColors maps the epoch types to the colors of the timeline.
+const epochColors = {
+	NRE: '#8884d8',
+	ORE: '#82ca9d',
+	URE: '#ffc658',
+};
+
+// epochStatus returns the rootchain status of an epoch.
+const epochStatus = (epoch) => {
+	if (epoch.finalized) {
+		return 'finalized';
+	}
+	if (epoch.submitted) {
+		return 'submitted';
+	}
+	return 'prepared';
+};
+
+// shortHash returns the abbreviation of a hex encoded hash.
+const shortHash = (hash: ?string) => (hash ? `${hash.substring(0, 10)}…${hash.substring(hash.length - 8)}` : '-');
+
+// header renders the header row of a table.
+const header = (titles: Array<string>) => (
+	<thead>
+		<tr>
+			{titles.map(title => <th key={title} style={styles.head}>{title}</th>)}
+		</tr>
+	</thead>
+);
+
+export type Props = {
+	content:      PlasmaContent,
+	shouldUpdate: Object,
+};
+
+// Plasma renders the operator's view of the plasma chain: the epoch timeline,
+// the block submission queue, the invalid exits and the request throughput.
+class Plasma extends Component<Props> {
+	shouldComponentUpdate(nextProps) {
+		return typeof nextProps.shouldUpdate.plasma !== 'undefined';
+	}
+
+	epochs = () => (
+		<div style={styles.section}>
+			<Typography type='title'>Epochs</Typography>
+			<table style={styles.table}>
+				{header(['Epoch', 'Type', 'Blocks', 'Status'])}
+				<tbody>
+					{this.props.content.epochs.slice().reverse().map(epoch => (
+						<tr key={`${epoch.fork}-${epoch.number}`}>
+							<td style={styles.cell}>{epoch.fork}/{epoch.number}</td>
+							<td style={{...styles.cell, color: epochColors[epoch.type]}}>{epoch.type}</td>
+							<td style={styles.cell}>{epoch.empty ? 'empty' : `#${epoch.startBlock} - #${epoch.endBlock}`}</td>
+							<td style={styles.cell}>{epochStatus(epoch)}</td>
+						</tr>
+					))}
+				</tbody>
+			</table>
+		</div>
+	);
+
+	submissions = () => (
+		<div style={styles.section}>
+			<Typography type='title'>Submissions</Typography>
+			<table style={styles.table}>
+				{header(['Block', 'Method', 'Transaction', 'Gas used', 'Status'])}
+				<tbody>
+					{this.props.content.submissions.slice().reverse().map(sub => (
+						<tr key={sub.txHash}>
+							<td style={styles.cell}>#{sub.block}</td>
+							<td style={styles.cell}>{sub.method}</td>
+							<td style={styles.hash}>{shortHash(sub.txHash)}</td>
+							<td style={styles.cell}>{sub.gasUsed}</td>
+							<td style={styles.cell}>{sub.status}</td>
+						</tr>
+					))}
+				</tbody>
+			</table>
+		</div>
+	);
+
+	invalidExits = () => (
+		<div style={styles.section}>
+			<Typography type='title'>Invalid exits</Typography>
+			<table style={styles.table}>
+				{header(['Block', 'Transaction', 'Reason', 'Challenge'])}
+				<tbody>
+					{this.props.content.invalidExits.map(exit => (
+						<tr key={exit.txHash}>
+							<td style={styles.cell}>{exit.fork}/#{exit.block}</td>
+							<td style={styles.hash}>{shortHash(exit.txHash)}</td>
+							<td style={styles.cell}>{exit.reason || '-'}</td>
+							<td style={styles.hash}>{shortHash(exit.challengeTx)}</td>
+						</tr>
+					))}
+				</tbody>
+			</table>
+		</div>
+	);
+
+	requests = () => (
+		<div style={styles.section}>
+			<Typography type='title'>Request throughput</Typography>
+			<div style={styles.chart}>
+				<ResponsiveContainer width='100%' height='100%'>
+					<AreaChart data={this.props.content.requests.map(({value}) => ({requests: value || 0}))}>
+						<Tooltip cursor={false} content={<CustomTooltip tooltip={ratePlotter('Requests')} />} />
+						<Area isAnimationActive={false} type='monotone' dataKey='requests' stroke='#82ca9d' fill='#82ca9d' />
+					</AreaChart>
+				</ResponsiveContainer>
+			</div>
+		</div>
+	);
+
+	render() {
+		return (
+			<div>
+				{this.requests()}
+				{this.epochs()}
+				{this.submissions()}
+				{this.invalidExits()}
+			</div>
+		);
+	}
+}
+
+export default Plasma;
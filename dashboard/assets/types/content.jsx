@@ -24,6 +24,7 @@ export type Content = {
 	network: Network,
 	system:  System,
 	logs:    Logs,
+	plasma:  Plasma,
 };
 
 export type ChartEntries = Array<ChartEntry>;
@@ -94,3 +95,39 @@ export type LogFile = {
 	name: string,
 	last: string,
 };
+
+export type Plasma = {
+	epochs:       Array<Epoch>,
+	submissions:  Array<Submission>,
+	invalidExits: Array<InvalidExit>,
+	requests:     ChartEntries,
+};
+
+export type Epoch = {
+	fork:       number,
+	number:     number,
+	type:       string,
+	startBlock: number,
+	endBlock:   number,
+	empty:      boolean,
+	submitted:  boolean,
+	finalized:  boolean,
+};
+
+export type Submission = {
+	block:   number,
+	method:  string,
+	txHash:  string,
+	gasUsed: number,
+	status:  string,
+	time:    Date,
+};
+
+export type InvalidExit = {
+	fork:        number,
+	block:       number,
+	index:       number,
+	txHash:      string,
+	reason:      ?string,
+	challengeTx: ?string,
+};
@@ -67,6 +67,7 @@ type Dashboard struct {
 	lock     sync.RWMutex // Lock protecting the dashboard's internals
 
 	logdir string
+	plasma PlasmaBackend // Plasma backend to retrieve the status of the plasma chain, nil if not running
 
 	quit chan chan error // Channel used for graceful exit
 	wg   sync.WaitGroup
@@ -79,8 +80,9 @@ type client struct {
 	logger log.Logger      // Logger for the particular live websocket connection
 }
 
-// New creates a new dashboard instance with the given configuration.
-func New(config *Config, commit string, logdir string) *Dashboard {
+// New creates a new dashboard instance with the given configuration. The plasma
+// panel is fed only if the plasma backend is given.
+func New(config *Config, commit string, logdir string, plasma PlasmaBackend) *Dashboard {
 	now := time.Now()
 	versionMeta := ""
 	if len(params.VersionMeta) > 0 {
@@ -105,8 +107,12 @@ func New(config *Config, commit string, logdir string) *Dashboard {
 				DiskRead:       emptyChartEntries(now, diskReadSampleLimit, config.Refresh),
 				DiskWrite:      emptyChartEntries(now, diskWriteSampleLimit, config.Refresh),
 			},
+			Plasma: &PlasmaMessage{
+				Requests: emptyChartEntries(now, requestSampleLimit, config.Refresh),
+			},
 		},
 		logdir: logdir,
+		plasma: plasma,
 	}
 }
 
@@ -132,9 +138,10 @@ func (db *Dashboard) APIs() []rpc.API { return nil }
 func (db *Dashboard) Start(server *p2p.Server) error {
 	log.Info("Starting dashboard")
 
-	db.wg.Add(2)
+	db.wg.Add(3)
 	go db.collectData()
 	go db.streamLogs()
+	go db.collectPlasma()
 
 	http.HandleFunc("/", db.webHandler)
 	http.Handle("/api", websocket.Handler(db.apiHandler))
@@ -160,7 +167,7 @@ func (db *Dashboard) Stop() error {
 	}
 	// Close the collectors.
 	errc := make(chan error, 1)
-	for i := 0; i < 2; i++ {
+	for i := 0; i < 3; i++ {
 		db.quit <- errc
 		if err := <-errc; err != nil {
 			errs = append(errs, err)
@@ -29,6 +29,7 @@ type Message struct {
 	Network *NetworkMessage `json:"network,omitempty"`
 	System  *SystemMessage  `json:"system,omitempty"`
 	Logs    *LogsMessage    `json:"logs,omitempty"`
+	Plasma  *PlasmaMessage  `json:"plasma,omitempty"`
 }
 
 type ChartEntries []*ChartEntry
@@ -70,6 +71,49 @@ type SystemMessage struct {
 	DiskWrite      ChartEntries `json:"diskWrite,omitempty"`
 }
 
+// PlasmaMessage contains the operator's view of the plasma chain. The epochs, the
+// submissions and the invalid exits replace the previous ones, the request
+// throughput samples are appended.
+type PlasmaMessage struct {
+	Epochs       []*EpochEntry       `json:"epochs,omitempty"`       // The latest epochs of the current fork.
+	Submissions  []*SubmissionEntry  `json:"submissions,omitempty"`  // The latest block submissions.
+	InvalidExits []*InvalidExitEntry `json:"invalidExits,omitempty"` // The detected invalid exits.
+	Requests     ChartEntries        `json:"requests,omitempty"`     // Applied requests per second.
+}
+
+// EpochEntry is the rootchain status of an epoch.
+type EpochEntry struct {
+	Fork       uint64 `json:"fork"`
+	Number     uint64 `json:"number"`
+	Type       string `json:"type"` // NRE, ORE or URE
+	StartBlock uint64 `json:"startBlock"`
+	EndBlock   uint64 `json:"endBlock"`
+	Empty      bool   `json:"empty"`
+	Submitted  bool   `json:"submitted"`
+	Finalized  bool   `json:"finalized"`
+}
+
+// SubmissionEntry is a block submission transaction sent to the rootchain.
+type SubmissionEntry struct {
+	Block   uint64    `json:"block"`
+	Method  string    `json:"method"`
+	TxHash  string    `json:"txHash"`
+	GasUsed uint64    `json:"gasUsed"`
+	Status  string    `json:"status"`
+	Time    time.Time `json:"time"`
+}
+
+// InvalidExitEntry is a failed exit detected in a request block and the
+// challenge sent against it.
+type InvalidExitEntry struct {
+	Fork        uint64 `json:"fork"`
+	Block       uint64 `json:"block"`
+	Index       int64  `json:"index"`
+	TxHash      string `json:"txHash"`
+	Reason      string `json:"reason,omitempty"`
+	ChallengeTx string `json:"challengeTx,omitempty"`
+}
+
 // LogsMessage wraps up a log chunk. If Source isn't present, the chunk is a stream chunk.
 type LogsMessage struct {
 	Source *LogFile        `json:"source,omitempty"` // Attributes of the log file.
@@ -0,0 +1,113 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package dashboard
+
+import (
+	"time"
+
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/log"
+)
+
+const (
+	requestSampleLimit = 200 // Maximum number of request throughput data samples
+	plasmaEpochLimit   = 20  // Maximum number of epochs shown on the timeline
+)
+
+// PlasmaBackend is the part of the plasma service the plasma panel reports on.
+// The rootchain status is converted to the dashboard entries by the caller, so
+// the dashboard does not depend on the full node.
+type PlasmaBackend interface {
+	// CurrentBlock returns the head block of the plasma chain.
+	CurrentBlock() *types.Block
+
+	// GetBlockByNumber returns the plasma block of the given number, nil if unknown.
+	GetBlockByNumber(number uint64) *types.Block
+
+	// Epochs returns at most limit of the latest epochs of the current fork.
+	Epochs(limit int) ([]*EpochEntry, error)
+
+	// Submissions returns the latest block submissions sent to the rootchain.
+	Submissions() []*SubmissionEntry
+
+	// InvalidExits returns the invalid exits detected in the request blocks.
+	InvalidExits() []*InvalidExitEntry
+}
+
+// collectPlasma collects the status of the plasma chain from the plasma backend,
+// if there is one.
+func (db *Dashboard) collectPlasma() {
+	defer db.wg.Done()
+
+	var errc chan error
+	defer func() {
+		if errc == nil {
+			errc = <-db.quit
+		}
+		errc <- nil
+	}()
+
+	if db.plasma == nil {
+		return
+	}
+	var (
+		frequency = float64(db.config.Refresh / time.Second)
+		last      = db.plasma.CurrentBlock().NumberU64()
+	)
+	for {
+		select {
+		case errc = <-db.quit:
+			return
+		case <-time.After(db.config.Refresh):
+			// Count the requests applied by the request blocks since the last tick
+			var applied int
+			head := db.plasma.CurrentBlock().NumberU64()
+			for number := last + 1; number <= head; number++ {
+				if block := db.plasma.GetBlockByNumber(number); block != nil && block.IsRequest() {
+					applied += len(block.Transactions())
+				}
+			}
+			last = head
+
+			msg := &PlasmaMessage{
+				Requests: ChartEntries{{
+					Time:  time.Now(),
+					Value: float64(applied) / frequency,
+				}},
+				Submissions:  db.plasma.Submissions(),
+				InvalidExits: db.plasma.InvalidExits(),
+			}
+			if epochs, err := db.plasma.Epochs(plasmaEpochLimit); err != nil {
+				log.Debug("Failed to retrieve plasma epochs", "err", err)
+			} else {
+				msg.Epochs = epochs
+			}
+
+			plasma := db.history.Plasma
+			db.lock.Lock()
+			plasma.Requests = append(plasma.Requests[1:], msg.Requests...)
+			if msg.Epochs != nil {
+				plasma.Epochs = msg.Epochs
+			}
+			plasma.Submissions = msg.Submissions
+			plasma.InvalidExits = msg.InvalidExits
+			db.lock.Unlock()
+
+			db.sendToAll(&Message{Plasma: msg})
+		}
+	}
+}
@@ -373,9 +373,9 @@ func (s *Service) login(conn *websocket.Conn) error {
 	infos := s.server.NodeInfo()
 
 	var network, protocol string
-	if info := infos.Protocols["eth"]; info != nil {
+	if info := infos.Protocols[pls.ProtocolName]; info != nil {
 		network = fmt.Sprintf("%d", info.(*pls.NodeInfo).Network)
-		protocol = fmt.Sprintf("eth/%d", pls.ProtocolVersions[0])
+		protocol = fmt.Sprintf("%s/%d", pls.ProtocolName, pls.ProtocolVersions[0])
 	} else {
 		network = fmt.Sprintf("%d", infos.Protocols["les"].(*les.NodeInfo).Network)
 		protocol = fmt.Sprintf("les/%d", les.ClientProtocolVersions[0])
@@ -670,6 +670,43 @@ type nodeStats struct {
 	Peers    int  `json:"peers"`
 	GasPrice int  `json:"gasPrice"`
 	Uptime   int  `json:"uptime"`
+
+	Plasma *plasmaStats `json:"plasma,omitempty"`
+}
+
+// plasmaStats is the information to report about the plasma chain as tracked by
+// the operator on the rootchain.
+type plasmaStats struct {
+	CurrentFork        uint64 `json:"currentFork"`
+	CurrentEpoch       uint64 `json:"currentEpoch"`
+	EpochType          string `json:"epochType"`
+	LastSubmittedBlock uint64 `json:"lastSubmittedBlock"`
+	LastFinalizedBlock uint64 `json:"lastFinalizedBlock"`
+	PendingEnters      int    `json:"pendingEnters"`
+	PendingExits       int    `json:"pendingExits"`
+	OperatorBalance    string `json:"operatorBalance"`
+	SubmissionLatency  int    `json:"submissionLatency"` // milliseconds
+}
+
+// assemblePlasmaStats retrieves the plasma chain status from the rootchain
+// manager, returning nil if the rootchain isn't reachable.
+func (s *Service) assemblePlasmaStats() *plasmaStats {
+	status, err := s.pls.RootChainManager().Status()
+	if err != nil {
+		log.Debug("Failed to retrieve plasma status", "err", err)
+		return nil
+	}
+	return &plasmaStats{
+		CurrentFork:        status.CurrentFork,
+		CurrentEpoch:       status.CurrentEpoch,
+		EpochType:          status.EpochType,
+		LastSubmittedBlock: status.LastSubmittedBlock,
+		LastFinalizedBlock: status.LastFinalizedBlock,
+		PendingEnters:      status.PendingEnters,
+		PendingExits:       status.PendingExits,
+		OperatorBalance:    status.OperatorBalance.String(),
+		SubmissionLatency:  int(status.SubmissionLatency / time.Millisecond),
+	}
 }
 
 // reportPending retrieves various stats about the node at the networking and
@@ -681,10 +718,12 @@ func (s *Service) reportStats(conn *websocket.Conn) error {
 		hashrate int
 		syncing  bool
 		gasprice int
+		plasma   *plasmaStats
 	)
 	if s.pls != nil {
 		mining = s.pls.Miner().Mining()
 		hashrate = int(s.pls.Miner().HashRate())
+		plasma = s.assemblePlasmaStats()
 
 		sync := s.pls.Downloader().Progress()
 		syncing = s.pls.BlockChain().CurrentHeader().Number.Uint64() >= sync.HighestBlock
@@ -708,6 +747,7 @@ func (s *Service) reportStats(conn *websocket.Conn) error {
 			GasPrice: gasprice,
 			Syncing:  syncing,
 			Uptime:   100,
+			Plasma:   plasma,
 		},
 	}
 	report := map[string][]interface{}{
@@ -27,6 +27,7 @@ import (
 	"github.com/Onther-Tech/plasma-evm/log"
 	"github.com/Onther-Tech/plasma-evm/metrics"
 	"github.com/Onther-Tech/plasma-evm/metrics/exp"
+	"github.com/Onther-Tech/plasma-evm/metrics/prometheus"
 	"github.com/fjl/memsize/memsizeui"
 	colorable "github.com/mattn/go-colorable"
 	"github.com/mattn/go-isatty"
@@ -157,6 +158,8 @@ func StartPProf(address string) {
 	// Hook go-metrics into expvar on any /debug/metrics request, load all vars
 	// from the registry into expvar, and execute regular expvar handler.
 	exp.Exp(metrics.DefaultRegistry)
+	// Serve the same registry to Prometheus scrapers.
+	http.Handle("/debug/metrics/prometheus", prometheus.Handler(metrics.DefaultRegistry))
 	http.Handle("/memsize/", http.StripPrefix("/memsize", &Memsize))
 	log.Info("Starting pprof server", "addr", fmt.Sprintf("http://%s/debug/pprof", address))
 	go func() {
@@ -67,6 +67,19 @@ func (s *PublicPlasmaAPI) GasPrice(ctx context.Context) (*hexutil.Big, error) {
 	return (*hexutil.Big)(price), err
 }
 
+// GasPrices returns the gas price suggestions for the next non-request block
+// and for fast inclusion.
+func (s *PublicPlasmaAPI) GasPrices(ctx context.Context) (map[string]*hexutil.Big, error) {
+	suggestion, err := s.b.SuggestPrices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]*hexutil.Big{
+		"nextNRB": (*hexutil.Big)(suggestion.NextNRB),
+		"fast":    (*hexutil.Big)(suggestion.Fast),
+	}, nil
+}
+
 // ProtocolVersion returns the current Ethereum protocol version this node supports
 func (s *PublicPlasmaAPI) ProtocolVersion() hexutil.Uint {
 	return hexutil.Uint(s.b.ProtocolVersion())
@@ -130,15 +143,29 @@ func (s *PublicTxPoolAPI) Content() map[string]map[string]map[string]*RPCTransac
 		}
 		content["queued"][account.Hex()] = dump
 	}
+	// Flatten the request transactions by their request block
+	content["requests"] = make(map[string]map[string]*RPCTransaction)
+	for i, txs := range s.b.TxPoolRequests() {
+		dump := make(map[string]*RPCTransaction)
+		for j, tx := range txs {
+			dump[fmt.Sprintf("%d", j)] = newRPCPendingTransaction(tx)
+		}
+		content["requests"][fmt.Sprintf("%d", i)] = dump
+	}
 	return content
 }
 
-// Status returns the number of pending and queued transaction in the pool.
+// Status returns the number of pending, queued and request transaction in the
+// pool, and the number of request blocks to be mined.
 func (s *PublicTxPoolAPI) Status() map[string]hexutil.Uint {
 	pending, queue := s.b.Stats()
+	blocks, requests := s.b.RequestStats()
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(pending),
-		"queued":  hexutil.Uint(queue),
+		"pending":       hexutil.Uint(pending),
+		"queued":        hexutil.Uint(queue),
+		"requests":      hexutil.Uint(requests),
+		"requestBlocks": hexutil.Uint(blocks),
 	}
 }
 
@@ -174,6 +201,15 @@ func (s *PublicTxPoolAPI) Inspect() map[string]map[string]map[string]string {
 		}
 		content["queued"][account.Hex()] = dump
 	}
+	// Flatten the request transactions by their request block
+	content["requests"] = make(map[string]map[string]string)
+	for i, txs := range s.b.TxPoolRequests() {
+		dump := make(map[string]string)
+		for j, tx := range txs {
+			dump[fmt.Sprintf("%d", j)] = format(tx)
+		}
+		content["requests"][fmt.Sprintf("%d", i)] = dump
+	}
 	return content
 }
 
@@ -601,6 +637,76 @@ func (s *PublicBlockChainAPI) GetStorageAt(ctx context.Context, address common.A
 	return res[:], state.Error()
 }
 
+// AccountResult is the result of GetProof as specified by EIP-1186.
+type AccountResult struct {
+	Address      common.Address  `json:"address"`
+	AccountProof []string        `json:"accountProof"`
+	Balance      *hexutil.Big    `json:"balance"`
+	CodeHash     common.Hash     `json:"codeHash"`
+	Nonce        hexutil.Uint64  `json:"nonce"`
+	StorageHash  common.Hash     `json:"storageHash"`
+	StorageProof []StorageResult `json:"storageProof"`
+}
+
+// StorageResult is the merkle proof of a single storage slot.
+type StorageResult struct {
+	Key   string       `json:"key"`
+	Value *hexutil.Big `json:"value"`
+	Proof []string     `json:"proof"`
+}
+
+// GetProof returns the Merkle-proof for a given account and optionally some
+// storage keys against the state root of the given block. For a finalized
+// plasma block the state root is the statesRoot held by the RootChain contract.
+func (s *PublicBlockChainAPI) GetProof(ctx context.Context, address common.Address, storageKeys []string, blockNr rpc.BlockNumber) (*AccountResult, error) {
+	state, _, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
+	if state == nil || err != nil {
+		return nil, err
+	}
+
+	storageTrie := state.StorageTrie(address)
+	storageHash := types.EmptyRootHash
+	codeHash := state.GetCodeHash(address)
+	storageProof := make([]StorageResult, len(storageKeys))
+
+	// if we have a storageTrie, (which means the account exists), we can update the storagehash
+	if storageTrie != nil {
+		storageHash = storageTrie.Hash()
+	} else {
+		// no storageTrie means the account does not exist, so the codeHash is the hash of an empty bytearray.
+		codeHash = crypto.Keccak256Hash(nil)
+	}
+
+	// create the proof for the storageKeys
+	for i, key := range storageKeys {
+		if storageTrie != nil {
+			proof, err := state.GetStorageProof(address, common.HexToHash(key))
+			if err != nil {
+				return nil, err
+			}
+			storageProof[i] = StorageResult{key, (*hexutil.Big)(state.GetState(address, common.HexToHash(key)).Big()), common.ToHexArray(proof)}
+		} else {
+			storageProof[i] = StorageResult{key, &hexutil.Big{}, []string{}}
+		}
+	}
+
+	// create the accountProof
+	accountProof, err := state.GetProof(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountResult{
+		Address:      address,
+		AccountProof: common.ToHexArray(accountProof),
+		Balance:      (*hexutil.Big)(state.GetBalance(address)),
+		CodeHash:     codeHash,
+		Nonce:        hexutil.Uint64(state.GetNonce(address)),
+		StorageHash:  storageHash,
+		StorageProof: storageProof,
+	}, state.Error()
+}
+
 // CallArgs represents the arguments for a call.
 type CallArgs struct {
 	From     common.Address  `json:"from"`
@@ -1087,6 +1193,9 @@ func (s *PublicTransactionPoolAPI) GetTransactionReceipt(ctx context.Context, ha
 	if receipt.ContractAddress != (common.Address{}) {
 		fields["contractAddress"] = receipt.ContractAddress
 	}
+	if receipt.RevertReason != "" {
+		fields["revertReason"] = receipt.RevertReason
+	}
 	return fields, nil
 }
 
@@ -0,0 +1,213 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package rootchaintest implements an in-process rootchain node for the tests
+// of the packages talking to the RootChain contract.
+package rootchaintest
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/Onther-Tech/plasma-evm/accounts/abi"
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/common/hexutil"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/ethclient"
+	"github.com/Onther-Tech/plasma-evm/rlp"
+	"github.com/Onther-Tech/plasma-evm/rpc"
+)
+
+// Call answers a call of a contract method with its outputs.
+type Call func(args []interface{}) ([]interface{}, error)
+
+// Backend is an in-process rootchain node serving the subset of the eth
+// namespace used by the plasma clients. Calls are dispatched to the handlers by
+// method name, unhandled methods return zero values.
+//
+// The exported fields may be changed by the tests while the backend is in use
+// if the backend is locked.
+type Backend struct {
+	ABI  abi.ABI
+	Code map[common.Address][]byte
+	Logs []types.Log
+	Head uint64
+
+	Sent     []*types.Transaction
+	Receipts map[common.Hash]*types.Receipt
+	Queries  [][2]uint64 // block ranges of the served log queries
+
+	handlers map[string]Call
+	calls    map[string]int
+
+	sync.Mutex
+}
+
+// NewBackend creates a rootchain node serving a contract of the given ABI.
+func NewBackend(contractABI abi.ABI) *Backend {
+	return &Backend{
+		ABI:      contractABI,
+		Code:     make(map[common.Address][]byte),
+		Receipts: make(map[common.Hash]*types.Receipt),
+		handlers: make(map[string]Call),
+		calls:    make(map[string]int),
+	}
+}
+
+// Client returns an ethclient connected to the backend.
+func (b *Backend) Client() *ethclient.Client {
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", &Service{b}); err != nil {
+		panic(err)
+	}
+	return ethclient.NewClient(rpc.DialInProc(server))
+}
+
+// Handle sets the handler of the calls of a contract method.
+func (b *Backend) Handle(method string, call Call) {
+	b.Lock()
+	defer b.Unlock()
+
+	b.handlers[method] = call
+}
+
+// Calls returns the number of calls of a contract method served so far.
+func (b *Backend) Calls(method string) int {
+	b.Lock()
+	defer b.Unlock()
+
+	return b.calls[method]
+}
+
+type CallArgs struct {
+	From *common.Address `json:"from"`
+	To   *common.Address `json:"to"`
+	Data hexutil.Bytes   `json:"data"`
+}
+
+type FilterArgs struct {
+	Address   []common.Address `json:"address"`
+	Topics    [][]common.Hash  `json:"topics"`
+	FromBlock rpc.BlockNumber  `json:"fromBlock"`
+	ToBlock   rpc.BlockNumber  `json:"toBlock"`
+}
+
+// Service is the eth namespace of Backend.
+type Service struct {
+	b *Backend
+}
+
+func (s *Service) Call(args CallArgs, blockNr string) (hexutil.Bytes, error) {
+	b := s.b
+	if len(args.Data) < 4 {
+		return nil, errors.New("no method id")
+	}
+	method, err := b.ABI.MethodById(args.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	b.Lock()
+	call := b.handlers[method.Name]
+	b.calls[method.Name]++
+	b.Unlock()
+
+	if call == nil {
+		return make([]byte, 32*len(method.Outputs)), nil
+	}
+	inputs, err := method.Inputs.UnpackValues(args.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	outputs, err := call(inputs)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(outputs...)
+}
+
+func (s *Service) GetCode(addr common.Address, blockNr string) (hexutil.Bytes, error) {
+	s.b.Lock()
+	defer s.b.Unlock()
+
+	return s.b.Code[addr], nil
+}
+
+func (s *Service) GetBalance(addr common.Address, blockNr string) (*hexutil.Big, error) {
+	return new(hexutil.Big), nil
+}
+
+func (s *Service) GetTransactionCount(addr common.Address, blockNr string) (hexutil.Uint64, error) {
+	s.b.Lock()
+	defer s.b.Unlock()
+
+	return hexutil.Uint64(len(s.b.Sent)), nil
+}
+
+func (s *Service) GetBlockByNumber(blockNr string, full bool) (*types.Header, error) {
+	s.b.Lock()
+	defer s.b.Unlock()
+
+	return &types.Header{
+		Number:     new(big.Int).SetUint64(s.b.Head),
+		Difficulty: new(big.Int),
+		Time:       new(big.Int),
+	}, nil
+}
+
+func (s *Service) GetLogs(crit FilterArgs) ([]types.Log, error) {
+	b := s.b
+	b.Lock()
+	defer b.Unlock()
+
+	from, to := uint64(crit.FromBlock.Int64()), uint64(crit.ToBlock.Int64())
+	if crit.ToBlock < 0 {
+		to = b.Head
+	}
+	b.Queries = append(b.Queries, [2]uint64{from, to})
+
+	logs := make([]types.Log, 0)
+	for _, l := range b.Logs {
+		if l.BlockNumber >= from && l.BlockNumber <= to {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+func (s *Service) SendRawTransaction(encoded hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := rlp.DecodeBytes(encoded, tx); err != nil {
+		return common.Hash{}, err
+	}
+	s.b.Lock()
+	defer s.b.Unlock()
+
+	s.b.Sent = append(s.b.Sent, tx)
+	s.b.Receipts[tx.Hash()] = &types.Receipt{
+		Status: types.ReceiptStatusSuccessful,
+		TxHash: tx.Hash(),
+		Logs:   []*types.Log{},
+	}
+	return tx.Hash(), nil
+}
+
+func (s *Service) GetTransactionReceipt(hash common.Hash) (*types.Receipt, error) {
+	s.b.Lock()
+	defer s.b.Unlock()
+
+	return s.b.Receipts[hash], nil
+}
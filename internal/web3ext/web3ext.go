@@ -18,19 +18,21 @@
 package web3ext
 
 var Modules = map[string]string{
-	"admin":      Admin_JS,
-	"chequebook": Chequebook_JS,
-	"clique":     Clique_JS,
-	"ethash":     Ethash_JS,
-	"debug":      Debug_JS,
-	"eth":        Eth_JS,
-	"miner":      Miner_JS,
-	"net":        Net_JS,
-	"personal":   Personal_JS,
-	"rpc":        RPC_JS,
-	"shh":        Shh_JS,
-	"swarmfs":    SWARMFS_JS,
-	"txpool":     TxPool_JS,
+	"admin":        Admin_JS,
+	"chequebook":   Chequebook_JS,
+	"clique":       Clique_JS,
+	"cliqueplasma": CliquePlasma_JS,
+	"ethash":       Ethash_JS,
+	"debug":        Debug_JS,
+	"eth":          Eth_JS,
+	"miner":        Miner_JS,
+	"net":          Net_JS,
+	"personal":     Personal_JS,
+	"pls":          Pls_JS,
+	"rpc":          RPC_JS,
+	"shh":          Shh_JS,
+	"swarmfs":      SWARMFS_JS,
+	"txpool":       TxPool_JS,
 }
 
 const Chequebook_JS = `
@@ -110,6 +112,31 @@ web3._extend({
 });
 `
 
+const CliquePlasma_JS = `
+web3._extend({
+	property: 'cliqueplasma',
+	methods: [
+		new web3._extend.Method({
+			name: 'getSigner',
+			call: 'cliqueplasma_getSigner',
+			params: 1,
+			inputFormatter: [null]
+		}),
+		new web3._extend.Method({
+			name: 'getSignerAtHash',
+			call: 'cliqueplasma_getSignerAtHash',
+			params: 1
+		}),
+	],
+	properties: [
+		new web3._extend.Property({
+			name: 'operator',
+			getter: 'cliqueplasma_getOperator'
+		}),
+	]
+});
+`
+
 const Ethash_JS = `
 web3._extend({
 	property: 'ethash',
@@ -198,6 +225,12 @@ web3._extend({
 			name: 'stopWS',
 			call: 'admin_stopWS'
 		}),
+		new web3._extend.Method({
+			name: 'mapRequestableContract',
+			call: 'admin_mapRequestableContract',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputAddressFormatter]
+		}),
 	],
 	properties: [
 		new web3._extend.Property({
@@ -414,6 +447,12 @@ web3._extend({
 			params: 2,
 			inputFormatter: [null, null]
 		}),
+		new web3._extend.Method({
+			name: 'traceRequest',
+			call: 'debug_traceRequest',
+			params: 3,
+			inputFormatter: [web3._extend.utils.fromDecimal, null, null]
+		}),
 		new web3._extend.Method({
 			name: 'preimage',
 			call: 'debug_preimage',
@@ -456,6 +495,11 @@ web3._extend({
 			call: 'eth_chainId',
 			params: 0
 		}),
+		new web3._extend.Method({
+			name: 'gasPrices',
+			call: 'eth_gasPrices',
+			params: 0
+		}),
 		new web3._extend.Method({
 			name: 'sign',
 			call: 'eth_sign',
@@ -558,7 +602,12 @@ web3._extend({
 			call: 'miner_getHashrate'
 		}),
 	],
-	properties: []
+	properties: [
+		new web3._extend.Property({
+			name: 'epochStatus',
+			getter: 'miner_epochStatus'
+		}),
+	]
 });
 `
 
@@ -621,6 +670,59 @@ web3._extend({
 })
 `
 
+const Pls_JS = `
+web3._extend({
+	property: 'pls',
+	methods: [
+		new web3._extend.Method({
+			name: 'getPlasmaBlock',
+			call: 'pls_getPlasmaBlock',
+			params: 1,
+			inputFormatter: [web3._extend.utils.fromDecimal]
+		}),
+		new web3._extend.Method({
+			name: 'getExitRequests',
+			call: 'pls_getExitRequests',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, null]
+		}),
+		new web3._extend.Method({
+			name: 'getRootchainEvents',
+			call: 'pls_getRootchainEvents',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'getFailedRequests',
+			call: 'pls_getFailedRequests',
+			params: 3,
+			inputFormatter: [web3._extend.utils.fromDecimal, web3._extend.utils.fromDecimal, web3._extend.utils.fromDecimal]
+		}),
+	],
+	properties: [
+		new web3._extend.Property({
+			name: 'currentEpoch',
+			getter: 'pls_currentEpoch'
+		}),
+		new web3._extend.Property({
+			name: 'pendingRequests',
+			getter: 'pls_pendingRequests'
+		}),
+		new web3._extend.Property({
+			name: 'operatorStatus',
+			getter: 'pls_operatorStatus'
+		}),
+		new web3._extend.Property({
+			name: 'withholdingStatus',
+			getter: 'pls_withholdingStatus'
+		}),
+		new web3._extend.Property({
+			name: 'requestableContracts',
+			getter: 'pls_requestableContracts'
+		}),
+	]
+});
+`
+
 const RPC_JS = `
 web3._extend({
 	property: 'rpc',
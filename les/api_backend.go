@@ -175,6 +175,10 @@ func (b *LesApiBackend) SuggestPrice(ctx context.Context) (*big.Int, error) {
 	return b.gpo.SuggestPrice(ctx)
 }
 
+func (b *LesApiBackend) SuggestPrices(ctx context.Context) (*gasprice.Suggestion, error) {
+	return b.gpo.SuggestPrices(ctx)
+}
+
 func (b *LesApiBackend) ChainDb() ethdb.Database {
 	return b.pls.chainDb
 }
@@ -26,10 +26,13 @@ import (
 	"github.com/Onther-Tech/plasma-evm/common"
 	"github.com/Onther-Tech/plasma-evm/common/hexutil"
 	"github.com/Onther-Tech/plasma-evm/consensus"
+	"github.com/Onther-Tech/plasma-evm/consensus/cliqueplasma"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
 	"github.com/Onther-Tech/plasma-evm/core"
 	"github.com/Onther-Tech/plasma-evm/core/bloombits"
 	"github.com/Onther-Tech/plasma-evm/core/rawdb"
 	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/ethclient"
 	"github.com/Onther-Tech/plasma-evm/pls"
 	"github.com/Onther-Tech/plasma-evm/pls/downloader"
 	"github.com/Onther-Tech/plasma-evm/pls/filters"
@@ -74,6 +77,8 @@ type LightEthereum struct {
 	networkId     uint64
 	netRPCService *ethapi.PublicNetAPI
 
+	rootchainBackend *ethclient.Client // Connection to the RootChain contract, if any
+
 	wg sync.WaitGroup
 }
 
@@ -123,6 +128,15 @@ func New(ctx *node.ServiceContext, config *pls.Config) (*LightEthereum, error) {
 	if leth.blockchain, err = light.NewLightChain(leth.odr, leth.chainConfig, leth.engine); err != nil {
 		return nil, err
 	}
+	// Verify the headers against the RootChain contract if requested
+	if config.RootChainSync || chainConfig.CliquePlasma != nil {
+		if err := leth.anchorToRootChain(config); err != nil {
+			if leth.rootchainBackend != nil {
+				leth.rootchainBackend.Close()
+			}
+			return nil, err
+		}
+	}
 	// Note: AddChildIndexer starts the update process for the child
 	leth.bloomIndexer.AddChildIndexer(leth.bloomTrieIndexer)
 	leth.chtIndexer.Start(leth.blockchain)
@@ -148,6 +162,34 @@ func New(ctx *node.ServiceContext, config *pls.Config) (*LightEthereum, error) {
 	return leth, nil
 }
 
+// anchorToRootChain connects the RootChain contract to set the operator of the
+// plasma blocks, and to only accept headers matching the roots submitted to the
+// contract if rootchain sync is enabled.
+func (s *LightEthereum) anchorToRootChain(config *pls.Config) error {
+	backend, err := ethclient.Dial(config.RootChainURL)
+	if err != nil {
+		return err
+	}
+	s.rootchainBackend = backend
+
+	contract, err := rootchain.NewRootChain(config.RootChainContract, backend)
+	if err != nil {
+		return err
+	}
+	if engine, ok := s.engine.(*cliqueplasma.Clique); ok {
+		operator, err := contract.Operator(nil)
+		if err != nil {
+			return err
+		}
+		engine.SetOperator(operator)
+	}
+	if config.RootChainSync {
+		s.blockchain.SetHeaderAnchor(newRootchainAnchor(&contract.RootChainCaller))
+		log.Info("Light headers anchored to RootChain contract", "contract", config.RootChainContract)
+	}
+	return nil
+}
+
 func lesTopic(genesisHash common.Hash, protocolVersion uint) discv5.Topic {
 	var name string
 	switch protocolVersion {
@@ -186,7 +228,7 @@ func (s *LightDummyAPI) Mining() bool {
 // APIs returns the collection of RPC services the ethereum package offers.
 // NOTE, some of these services probably need to be moved to somewhere else.
 func (s *LightEthereum) APIs() []rpc.API {
-	return append(ethapi.GetAPIs(s.ApiBackend), []rpc.API{
+	apis := append(ethapi.GetAPIs(s.ApiBackend), []rpc.API{
 		{
 			Namespace: "eth",
 			Version:   "1.0",
@@ -209,6 +251,16 @@ func (s *LightEthereum) APIs() []rpc.API {
 			Public:    true,
 		},
 	}...)
+
+	// Serve the chain services under the "pls" namespace too, like full nodes
+	// do, so the pls console module works against light clients
+	for _, api := range apis {
+		if api.Namespace == "eth" {
+			api.Namespace = "pls"
+			apis = append(apis, api)
+		}
+	}
+	return apis
 }
 
 func (s *LightEthereum) ResetWithGenesisBlock(gb *types.Block) {
@@ -251,6 +303,9 @@ func (s *LightEthereum) Stop() error {
 	s.protocolManager.Stop()
 	s.txPool.Stop()
 	s.engine.Close()
+	if s.rootchainBackend != nil {
+		s.rootchainBackend.Close()
+	}
 
 	s.eventMux.Stop()
 
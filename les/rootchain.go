@@ -0,0 +1,115 @@
+package les
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/light"
+	"github.com/hashicorp/golang-lru"
+)
+
+const (
+	anchorRootsCacheLimit = 4096             // Number of anchored block roots to cache
+	anchorForksRefresh    = 15 * time.Second // Interval after which the fork ranges are read again
+)
+
+// anchorFork is the range of blocks submitted in a fork of the RootChain contract.
+type anchorFork struct {
+	first, last uint64
+}
+
+// anchorKey identifies the block of a fork in the roots cache.
+type anchorKey struct {
+	fork, number uint64
+}
+
+// rootchainAnchor is a light.HeaderAnchor serving the roots the operator
+// submitted to the RootChain contract. The fork ranges are only read again once
+// a header is beyond the last submitted block or they are stale, and the roots
+// of the submitted blocks are cached, so a batch of headers costs one contract
+// call per uncached block.
+type rootchainAnchor struct {
+	contract *rootchain.RootChainCaller
+	roots    *lru.Cache // Roots of the submitted blocks keyed by fork and number
+
+	forks   []anchorFork // Block ranges of the forks, indexed by fork number
+	updated time.Time    // Time the fork ranges were read
+	lock    sync.Mutex
+}
+
+func newRootchainAnchor(contract *rootchain.RootChainCaller) *rootchainAnchor {
+	roots, _ := lru.New(anchorRootsCacheLimit)
+	return &rootchainAnchor{
+		contract: contract,
+		roots:    roots,
+	}
+}
+
+// Roots retrieves the roots of the block submitted in the latest fork including
+// the block number, or nil if the block is not submitted yet.
+func (a *rootchainAnchor) Roots(number uint64) (*light.AnchoredRoots, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if len(a.forks) == 0 || number > a.forks[len(a.forks)-1].last || time.Since(a.updated) > anchorForksRefresh {
+		if err := a.updateForks(); err != nil {
+			return nil, err
+		}
+	}
+	fork, ok := a.fork(number)
+	if !ok {
+		return nil, nil
+	}
+	key := anchorKey{fork, number}
+	if roots, ok := a.roots.Get(key); ok {
+		return roots.(*light.AnchoredRoots), nil
+	}
+	b, err := a.contract.GetBlock(nil, new(big.Int).SetUint64(fork), new(big.Int).SetUint64(number))
+	if err != nil {
+		return nil, err
+	}
+	if b.StatesRoot == [32]byte{} {
+		return nil, nil
+	}
+	roots := &light.AnchoredRoots{
+		Root:        common.BytesToHash(b.StatesRoot[:]),
+		TxHash:      common.BytesToHash(b.TransactionsRoot[:]),
+		ReceiptHash: common.BytesToHash(b.ReceiptsRoot[:]),
+	}
+	a.roots.Add(key, roots)
+	return roots, nil
+}
+
+// updateForks reads the block ranges of all the forks.
+func (a *rootchainAnchor) updateForks() error {
+	currentFork, err := a.contract.CurrentFork(nil)
+	if err != nil {
+		return err
+	}
+	forks := make([]anchorFork, 0, currentFork.Uint64()+1)
+	for fork := uint64(0); fork <= currentFork.Uint64(); fork++ {
+		f, err := a.contract.Forks(nil, new(big.Int).SetUint64(fork))
+		if err != nil {
+			return err
+		}
+		forks = append(forks, anchorFork{f.FirstBlock, f.LastBlock})
+	}
+	a.forks, a.updated = forks, time.Now()
+	return nil
+}
+
+// fork returns the latest fork including the block number.
+func (a *rootchainAnchor) fork(number uint64) (uint64, bool) {
+	for fork := len(a.forks) - 1; fork >= 0; fork-- {
+		if number > a.forks[fork].last {
+			return 0, false
+		}
+		if number >= a.forks[fork].first || fork == 0 {
+			return uint64(fork), true
+		}
+	}
+	return 0, false
+}
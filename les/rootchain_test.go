@@ -0,0 +1,77 @@
+package les
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/Onther-Tech/plasma-evm/accounts/abi"
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/internal/rootchaintest"
+)
+
+// newTestRootChain returns a rootchain node serving the forks and blocks of a
+// RootChain contract.
+func newTestRootChain(t *testing.T, forks []anchorFork) *rootchaintest.Backend {
+	parsed, err := abi.JSON(strings.NewReader(rootchain.RootChainABI))
+	if err != nil {
+		t.Fatalf("failed to parse RootChain ABI: %v", err)
+	}
+	backend := rootchaintest.NewBackend(parsed)
+	backend.Handle("currentFork", func([]interface{}) ([]interface{}, error) {
+		return []interface{}{big.NewInt(int64(len(forks) - 1))}, nil
+	})
+	backend.Handle("forks", func(args []interface{}) ([]interface{}, error) {
+		f := forks[args[0].(*big.Int).Uint64()]
+		return []interface{}{uint64(0), uint64(0), uint64(0), f.first, f.last, uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), false}, nil
+	})
+	backend.Handle("getBlock", func(args []interface{}) ([]interface{}, error) {
+		fork, number := args[0].(*big.Int).Uint64(), args[1].(*big.Int).Uint64()
+		var root [32]byte
+		root[0], root[31] = byte(fork+1), byte(number)
+		return []interface{}{uint64(0), uint64(0), uint64(0), uint64(0), root, root, root, false, false, false, false, false}, nil
+	})
+	return backend
+}
+
+// Tests that the anchor looks the roots up in the latest fork including a block,
+// and that the fork ranges and roots are cached.
+func TestRootchainAnchor(t *testing.T) {
+	backend := newTestRootChain(t, []anchorFork{{1, 20}, {11, 15}})
+	client := backend.Client()
+	defer client.Close()
+
+	contract, err := rootchain.NewRootChainCaller(common.Address{}, client)
+	if err != nil {
+		t.Fatalf("failed to bind RootChain contract: %v", err)
+	}
+	anchor := newRootchainAnchor(contract)
+
+	for number := uint64(1); number <= 15; number++ {
+		roots, err := anchor.Roots(number)
+		if err != nil {
+			t.Fatalf("block %d: failed to retrieve roots: %v", number, err)
+		}
+		fork := byte(1)
+		if number >= 11 {
+			fork = 2
+		}
+		if roots == nil || roots.Root[0] != fork || roots.Root[31] != byte(number) {
+			t.Fatalf("block %d: roots mismatch: have %v, want fork %d", number, roots, fork-1)
+		}
+	}
+	if roots, err := anchor.Roots(16); roots != nil || err != nil {
+		t.Fatalf("block beyond the latest fork anchored: %v, %v", roots, err)
+	}
+	if _, err := anchor.Roots(15); err != nil {
+		t.Fatalf("failed to retrieve cached roots: %v", err)
+	}
+	// The forks are read on the first lookup and the lookup beyond the latest fork
+	if have, want := backend.Calls("currentFork"), 2; have != want {
+		t.Errorf("currentFork calls mismatch: have %d, want %d", have, want)
+	}
+	if have, want := backend.Calls("getBlock"), 15; have != want {
+		t.Errorf("getBlock calls mismatch: have %d, want %d", have, want)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package light
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+)
+
+// ErrHeaderNotAnchored is returned if no roots are committed to the anchor for a
+// header yet, so the header can't be trusted.
+var ErrHeaderNotAnchored = errors.New("header not committed to anchor")
+
+// AnchoredRoots are the roots of a block committed to a trusted anchor.
+type AnchoredRoots struct {
+	Root        common.Hash // Root of the state trie
+	TxHash      common.Hash // Root of the transaction trie
+	ReceiptHash common.Hash // Root of the receipt trie
+}
+
+// HeaderAnchor is a trusted source of block roots, e.g. the RootChain contract
+// of a plasma chain. A light chain with an anchor only accepts headers matching
+// the anchored roots, so ODR state, transaction and receipt retrievals are all
+// verified against them.
+type HeaderAnchor interface {
+	// Roots retrieves the roots committed for the block number, or nil if the
+	// block is not committed yet.
+	Roots(number uint64) (*AnchoredRoots, error)
+}
+
+// AnchorMismatchError is returned if a header doesn't match the anchored roots.
+type AnchorMismatchError struct {
+	Number uint64
+	Field  string
+	Have   common.Hash
+	Want   common.Hash
+}
+
+func (e *AnchorMismatchError) Error() string {
+	return fmt.Sprintf("header #%d %s mismatch: have %x, anchored %x", e.Number, e.Field, e.Have, e.Want)
+}
+
+// verifyAnchoredHeader checks the header against the roots committed to the
+// anchor. The genesis header is trusted as is.
+func verifyAnchoredHeader(anchor HeaderAnchor, header *types.Header) error {
+	number := header.Number.Uint64()
+	if number == 0 {
+		return nil
+	}
+	roots, err := anchor.Roots(number)
+	if err != nil {
+		return err
+	}
+	if roots == nil {
+		return ErrHeaderNotAnchored
+	}
+	for _, field := range []struct {
+		name       string
+		have, want common.Hash
+	}{
+		{"state root", header.Root, roots.Root},
+		{"transaction root", header.TxHash, roots.TxHash},
+		{"receipt root", header.ReceiptHash, roots.ReceiptHash},
+	} {
+		if field.have != field.want {
+			return &AnchorMismatchError{Number: number, Field: field.name, Have: field.have, Want: field.want}
+		}
+	}
+	return nil
+}
@@ -71,6 +71,7 @@ type LightChain struct {
 	wg            sync.WaitGroup
 
 	engine consensus.Engine
+	anchor HeaderAnchor // Trusted source of block roots (nil = trust validated headers)
 }
 
 // NewLightChain returns a fully initialised light chain using information
@@ -117,6 +118,15 @@ func NewLightChain(odr OdrBackend, config *params.ChainConfig, engine consensus.
 	return bc, nil
 }
 
+// SetHeaderAnchor sets the trusted source of block roots. Once set, headers are
+// only accepted if they match the anchored roots.
+func (self *LightChain) SetHeaderAnchor(anchor HeaderAnchor) {
+	self.chainmu.Lock()
+	defer self.chainmu.Unlock()
+
+	self.anchor = anchor
+}
+
 // addTrustedCheckpoint adds a trusted checkpoint to the blockchain
 func (self *LightChain) addTrustedCheckpoint(cp *params.TrustedCheckpoint) {
 	if self.odr.ChtIndexer() != nil {
@@ -367,6 +377,25 @@ func (self *LightChain) InsertHeaderChain(chain []*types.Header, checkFreq int)
 		time.Sleep(time.Millisecond * 10) // ugly hack; do not hog chain lock in case syncing is CPU-limited by validation
 	}()
 
+	// Only insert the headers matching the anchored roots. Headers not anchored
+	// yet are refused after the anchored ones are inserted.
+	var (
+		unanchored    int
+		unanchoredErr error
+	)
+	if self.anchor != nil {
+		for i, header := range chain {
+			if err := verifyAnchoredHeader(self.anchor, header); err != nil {
+				if err != ErrHeaderNotAnchored {
+					return i, err
+				}
+				unanchored, unanchoredErr = i, err
+				chain = chain[:i]
+				break
+			}
+		}
+	}
+
 	self.wg.Add(1)
 	defer self.wg.Done()
 
@@ -388,8 +417,14 @@ func (self *LightChain) InsertHeaderChain(chain []*types.Header, checkFreq int)
 		}
 		return err
 	}
+	if len(chain) == 0 {
+		return unanchored, unanchoredErr
+	}
 	i, err := self.hc.InsertHeaderChain(chain, whFunc, start)
 	self.postChainEvents(events)
+	if err == nil && unanchoredErr != nil {
+		return unanchored, unanchoredErr
+	}
 	return i, err
 }
 
@@ -459,7 +494,20 @@ func (self *LightChain) GetHeaderByNumberOdr(ctx context.Context, number uint64)
 	if header := self.hc.GetHeaderByNumber(number); header != nil {
 		return header, nil
 	}
-	return GetHeaderByNumber(ctx, self.odr, number)
+	header, err := GetHeaderByNumber(ctx, self.odr, number)
+	if err != nil {
+		return nil, err
+	}
+	self.chainmu.RLock()
+	anchor := self.anchor
+	self.chainmu.RUnlock()
+
+	if anchor != nil {
+		if err := verifyAnchoredHeader(anchor, header); err != nil {
+			return nil, err
+		}
+	}
+	return header, nil
 }
 
 // Config retrieves the header chain's chain configuration.
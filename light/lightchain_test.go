@@ -352,3 +352,54 @@ func TestReorgBadHeaderHashes(t *testing.T) {
 		t.Errorf("last header hash mismatch: have: %x, want %x", ncm.CurrentHeader().Hash(), headers[2].Hash())
 	}
 }
+
+// testAnchor is a HeaderAnchor serving the roots of a fixed set of headers.
+type testAnchor map[uint64]*AnchoredRoots
+
+func (a testAnchor) Roots(number uint64) (*AnchoredRoots, error) {
+	return a[number], nil
+}
+
+// Tests that only the headers matching the anchored roots are inserted.
+func TestAnchoredHeaders(t *testing.T) {
+	db, chain, err := newCanonical(0)
+	if err != nil {
+		t.Fatalf("failed to create pristine chain: %v", err)
+	}
+	defer chain.Stop()
+
+	headers := makeHeaderChain(chain.CurrentHeader(), 4, db, canonicalSeed)
+
+	// Anchor the first two headers only
+	anchor := make(testAnchor)
+	for _, header := range headers[:2] {
+		anchor[header.Number.Uint64()] = &AnchoredRoots{Root: header.Root, TxHash: header.TxHash, ReceiptHash: header.ReceiptHash}
+	}
+	chain.SetHeaderAnchor(anchor)
+
+	if i, err := chain.InsertHeaderChain(headers, 1); err != ErrHeaderNotAnchored || i != 2 {
+		t.Fatalf("unanchored insert mismatch: have %d, %v, want 2, %v", i, err, ErrHeaderNotAnchored)
+	}
+	if head := chain.CurrentHeader(); head.Hash() != headers[1].Hash() {
+		t.Fatalf("head mismatch: have #%d, want #%d", head.Number, headers[1].Number)
+	}
+
+	// A header not matching its anchored roots is refused
+	anchor[3] = &AnchoredRoots{Root: common.HexToHash("0xdead"), TxHash: headers[2].TxHash, ReceiptHash: headers[2].ReceiptHash}
+	if _, err := chain.InsertHeaderChain(headers[2:], 1); err == nil {
+		t.Fatal("mismatching header inserted")
+	} else if _, ok := err.(*AnchorMismatchError); !ok {
+		t.Fatalf("mismatch error type: have %T, want *AnchorMismatchError", err)
+	}
+
+	// Anchoring the rest lets them in
+	for _, header := range headers[2:] {
+		anchor[header.Number.Uint64()] = &AnchoredRoots{Root: header.Root, TxHash: header.TxHash, ReceiptHash: header.ReceiptHash}
+	}
+	if _, err := chain.InsertHeaderChain(headers[2:], 1); err != nil {
+		t.Fatalf("failed to insert anchored headers: %v", err)
+	}
+	if head := chain.CurrentHeader(); head.Hash() != headers[3].Hash() {
+		t.Fatalf("head mismatch: have #%d, want #%d", head.Number, headers[3].Number)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package prometheus exposes the metrics registry in the Prometheus text
+// exposition format.
+package prometheus
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Onther-Tech/plasma-evm/metrics"
+)
+
+// quantiles are the percentiles reported for histograms and timers.
+var quantiles = []float64{0.5, 0.75, 0.95, 0.99, 0.999}
+
+// Handler returns an HTTP handler which serves the metrics of the registry in
+// the Prometheus text exposition format.
+func Handler(reg metrics.Registry) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf := new(bytes.Buffer)
+		Write(buf, reg)
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+		w.Write(buf.Bytes())
+	})
+}
+
+// Write dumps the metrics of the registry in the Prometheus text exposition
+// format, sorted by name to get a stable listing.
+func Write(buf *bytes.Buffer, reg metrics.Registry) {
+	var names []string
+	reg.Each(func(name string, _ interface{}) {
+		names = append(names, name)
+	})
+	sort.Strings(names)
+
+	for _, name := range names {
+		key := mutateKey(name)
+
+		switch m := reg.Get(name).(type) {
+		case metrics.Counter:
+			writeValue(buf, key, "gauge", m.Count())
+		case metrics.Gauge:
+			writeValue(buf, key, "gauge", m.Value())
+		case metrics.GaugeFloat64:
+			writeValue(buf, key, "gauge", m.Value())
+		case metrics.Meter:
+			writeValue(buf, key+"_total", "counter", m.Snapshot().Count())
+		case metrics.Histogram:
+			h := m.Snapshot()
+			writeSummary(buf, key, h.Percentiles(quantiles), h.Sum(), h.Count())
+		case metrics.Timer:
+			t := m.Snapshot()
+			writeSummary(buf, key, t.Percentiles(quantiles), t.Sum(), t.Count())
+		case metrics.ResettingTimer:
+			t := m.Snapshot()
+			values := t.Values()
+			if len(values) == 0 {
+				continue
+			}
+			var sum int64
+			for _, v := range values {
+				sum += v
+			}
+			ps := make([]float64, len(quantiles))
+			for i, p := range t.Percentiles(quantiles) {
+				ps[i] = float64(p)
+			}
+			writeSummary(buf, key, ps, sum, int64(len(values)))
+		}
+	}
+}
+
+// writeValue writes a single sample metric of the given type.
+func writeValue(buf *bytes.Buffer, key string, typ string, value interface{}) {
+	fmt.Fprintf(buf, "# TYPE %s %s\n", key, typ)
+	fmt.Fprintf(buf, "%s %v\n", key, value)
+}
+
+// writeSummary writes a summary metric with the quantiles, the sum and the
+// count of the observations.
+func writeSummary(buf *bytes.Buffer, key string, ps []float64, sum int64, count int64) {
+	fmt.Fprintf(buf, "# TYPE %s summary\n", key)
+	for i, q := range quantiles {
+		fmt.Fprintf(buf, "%s{quantile=\"%s\"} %s\n", key, strconv.FormatFloat(q, 'f', -1, 64), strconv.FormatFloat(ps[i], 'f', -1, 64))
+	}
+	fmt.Fprintf(buf, "%s_sum %d\n", key, sum)
+	fmt.Fprintf(buf, "%s_count %d\n", key, count)
+}
+
+// mutateKey converts a registry metric name into a valid Prometheus metric
+// name, e.g. "pls/rootchain/blocks/submitted" to "pls_rootchain_blocks_submitted".
+func mutateKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == ':':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
@@ -0,0 +1,81 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package prometheus
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Onther-Tech/plasma-evm/metrics"
+)
+
+func TestWrite(t *testing.T) {
+	metrics.Enabled = true
+	reg := metrics.NewRegistry()
+
+	counter := metrics.NewRegisteredCounter("pls/rootchain/challenges", reg)
+	counter.Inc(3)
+
+	gauge := metrics.NewRegisteredGauge("pls/rootchain/epoch", reg)
+	gauge.Update(12)
+
+	meter := metrics.NewRegisteredMeter("pls/rootchain/blocks/submitted", reg)
+	meter.Mark(5)
+	defer meter.Stop()
+
+	timer := metrics.NewRegisteredTimer("pls/rootchain/requests/fetch", reg)
+	timer.Update(2 * time.Millisecond)
+	timer.Update(4 * time.Millisecond)
+	defer timer.Stop()
+
+	buf := new(bytes.Buffer)
+	Write(buf, reg)
+	have := buf.String()
+
+	want := []string{
+		"# TYPE pls_rootchain_blocks_submitted_total counter\npls_rootchain_blocks_submitted_total 5\n",
+		"# TYPE pls_rootchain_challenges gauge\npls_rootchain_challenges 3\n",
+		"# TYPE pls_rootchain_epoch gauge\npls_rootchain_epoch 12\n",
+		"# TYPE pls_rootchain_requests_fetch summary\n",
+		"pls_rootchain_requests_fetch{quantile=\"0.5\"} 3000000\n",
+		"pls_rootchain_requests_fetch_sum 6000000\npls_rootchain_requests_fetch_count 2\n",
+	}
+	for _, w := range want {
+		if !strings.Contains(have, w) {
+			t.Errorf("missing %q in output:\n%s", w, have)
+		}
+	}
+	// The metrics should be listed in the order of their names
+	if strings.Index(have, "blocks_submitted") > strings.Index(have, "challenges") {
+		t.Errorf("metrics not sorted:\n%s", have)
+	}
+}
+
+func TestMutateKey(t *testing.T) {
+	tests := map[string]string{
+		"pls/rootchain/blocks/submitted": "pls_rootchain_blocks_submitted",
+		"eth/db/chaindata/disk.read":     "eth_db_chaindata_disk_read",
+		"p2p/InboundTraffic":             "p2p_InboundTraffic",
+	}
+	for name, want := range tests {
+		if have := mutateKey(name); have != want {
+			t.Errorf("%s: have %s, want %s", name, have, want)
+		}
+	}
+}
@@ -11,3 +11,8 @@ type ORBEpochCompleted struct{}
 type EpochPrepared struct {
 	Payload *rootchain.RootChainEpochPrepared
 }
+
+// EpochStatusChanged is posted on every transition of the EpochEnvironment.
+type EpochStatusChanged struct {
+	Status EpochStatus
+}
@@ -24,7 +24,9 @@ import (
 	"time"
 
 	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/common/hexutil"
 	"github.com/Onther-Tech/plasma-evm/consensus"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
 	"github.com/Onther-Tech/plasma-evm/core"
 	"github.com/Onther-Tech/plasma-evm/core/state"
 	"github.com/Onther-Tech/plasma-evm/core/types"
@@ -59,6 +61,9 @@ type Miner struct {
 }
 
 func New(pls Backend, config *params.ChainConfig, mux *event.TypeMux, engine consensus.Engine, env *EpochEnvironment, recommit time.Duration, gasFloor, gasCeil uint64, isLocalBlock func(block *types.Block) bool) *Miner {
+	// The worker posts epoch status changes as soon as it starts
+	env.mux = mux
+
 	miner := &Miner{
 		pls:      pls,
 		mux:      mux,
@@ -86,40 +91,34 @@ func (self *Miner) operate() {
 			}
 			switch ev.Data.(type) {
 			case core.NewMinedBlockEvent:
-				if self.env.Completed == true {
+				if self.env.isCompleted() {
+					isRequest := self.env.isRequest()
+					if !self.env.completeEpoch() {
+						continue
+					}
 					self.Stop()
 					atomic.StoreInt32(&self.canStart, 2)
-					switch self.env.IsRequest {
+					switch isRequest {
 					case true:
-						self.env.setNumORBmined(big.NewInt(0))
 						log.Info("ORB epoch is completed, Waiting for preparing next epoch")
 					case false:
-						self.env.setNumNRBmined(big.NewInt(0))
 						log.Info("NRB epoch is completed, Waiting for preparing next epoch")
 					}
 				}
 			case EpochPrepared:
 				// start mining only when the epoch is prepared
 				atomic.StoreInt32(&self.canStart, 1)
-				self.env.setCompleted(false)
 				payload := ev.Data.(EpochPrepared).Payload
+				self.env.prepareEpoch(payload)
+				self.Start(params.Operator)
 				switch payload.IsRequest {
 				case true:
-					self.env.setORBepochLength(big.NewInt(0))
 					if payload.EpochIsEmpty == true {
-						self.env.setIsRequest(false)
-						self.Start(params.Operator)
 						log.Info("ORB epoch is empty, NRB epoch is started")
 					} else {
-						self.env.setIsRequest(true)
-						ORBepochLength := new(big.Int).Add(new(big.Int).Sub(payload.EndBlockNumber, payload.StartBlockNumber), big.NewInt(1))
-						self.env.setORBepochLength(ORBepochLength)
-						self.Start(params.Operator)
 						log.Info("ORB epoch is prepared, ORB epoch is started")
 					}
 				case false:
-					self.env.setIsRequest(false)
-					self.Start(params.Operator)
 					log.Info("NRB epoch is prepared, NRB epoch is started")
 				}
 			}
@@ -236,60 +235,217 @@ func (self *Miner) SetEtherbase(addr common.Address) {
 	self.worker.setEtherbase(addr)
 }
 
+// ResetEpoch stops mining and gates it until the next epoch is prepared, e.g.
+// after the chain is rewound to a rootchain fork.
+func (self *Miner) ResetEpoch() {
+	self.Stop()
+	atomic.StoreInt32(&self.canStart, 2)
+	self.env.reset()
+}
+
+// EpochStatus returns a snapshot of the epoch the miner is in.
+func (self *Miner) EpochStatus() EpochStatus {
+	return self.env.Status()
+}
+
 func (self *Miner) SetNRBepochLength(NRBepochLength *big.Int) {
 	self.env.setNRBepochLength(NRBepochLength)
 }
 
+// Epoch types as reported in the EpochStatus.
+const (
+	NRE = "NRE" // Non-request epoch
+	ORE = "ORE" // Operator request epoch
+	URE = "URE" // User request epoch
+)
+
+// EpochStatus is a consistent snapshot of the EpochEnvironment.
+type EpochStatus struct {
+	EpochNumber   hexutil.Uint64 `json:"epochNumber"`
+	Type          string         `json:"type"`
+	IsRequest     bool           `json:"isRequest"`
+	UserActivated bool           `json:"userActivated"`
+	NumMined      hexutil.Uint64 `json:"numMined"`
+	Length        hexutil.Uint64 `json:"length"`
+	Completed     bool           `json:"completed"`
+	Waiting       bool           `json:"waiting"` // mining is gated until the next EpochPrepared
+}
+
 type EpochEnvironment struct {
+	EpochNumber    *big.Int
 	IsRequest      bool
+	UserActivated  bool
 	NumNRBmined    *big.Int
 	NumORBmined    *big.Int
 	NRBepochLength *big.Int
 	ORBepochLength *big.Int
 	Completed      bool
+	Waiting        bool
 
-	lock sync.Mutex
+	mux  *event.TypeMux
+	lock sync.RWMutex
 }
 
 func NewEpochEnvironment() *EpochEnvironment {
 	return &EpochEnvironment{
+		EpochNumber:    big.NewInt(0),
 		IsRequest:      false,
 		NumNRBmined:    big.NewInt(0),
 		NumORBmined:    big.NewInt(0),
 		NRBepochLength: big.NewInt(0),
 		ORBepochLength: big.NewInt(0),
 		Completed:      false,
+		Waiting:        true,
 	}
 }
 
-func (env *EpochEnvironment) setCompleted(completed bool) {
+// Status returns a snapshot of the current epoch.
+func (env *EpochEnvironment) Status() EpochStatus {
+	env.lock.RLock()
+	defer env.lock.RUnlock()
+	return env.status()
+}
+
+func (env *EpochEnvironment) status() EpochStatus {
+	status := EpochStatus{
+		EpochNumber:   hexutil.Uint64(env.EpochNumber.Uint64()),
+		Type:          NRE,
+		IsRequest:     env.IsRequest,
+		UserActivated: env.UserActivated,
+		NumMined:      hexutil.Uint64(env.NumNRBmined.Uint64()),
+		Length:        hexutil.Uint64(env.NRBepochLength.Uint64()),
+		Completed:     env.Completed,
+		Waiting:       env.Waiting,
+	}
+	if env.IsRequest {
+		status.Type = ORE
+		if env.UserActivated {
+			status.Type = URE
+		}
+		status.NumMined = hexutil.Uint64(env.NumORBmined.Uint64())
+		status.Length = hexutil.Uint64(env.ORBepochLength.Uint64())
+	}
+	return status
+}
+
+// postStatus posts the status to the event mux, if any. It must not be called
+// with the lock held.
+func (env *EpochEnvironment) postStatus(status EpochStatus) {
+	if env.mux != nil {
+		env.mux.Post(EpochStatusChanged{Status: status})
+	}
+}
+
+func (env *EpochEnvironment) isRequest() bool {
+	env.lock.RLock()
+	defer env.lock.RUnlock()
+	return env.IsRequest
+}
+
+// ClosesEpoch reports whether the next block mined completes the current
+// epoch. Such a block is mined even if it is empty.
+func (env *EpochEnvironment) ClosesEpoch() bool {
+	env.lock.RLock()
+	defer env.lock.RUnlock()
+
+	if env.Completed || env.Waiting {
+		return false
+	}
+	if env.IsRequest {
+		return new(big.Int).Add(env.NumORBmined, big.NewInt(1)).Cmp(env.ORBepochLength) == 0
+	}
+	return new(big.Int).Add(env.NumNRBmined, big.NewInt(1)).Cmp(env.NRBepochLength) == 0
+}
+
+func (env *EpochEnvironment) isCompleted() bool {
+	env.lock.RLock()
+	defer env.lock.RUnlock()
+	return env.Completed
+}
+
+// prepareEpoch starts the epoch prepared in the RootChain contract.
+func (env *EpochEnvironment) prepareEpoch(payload *rootchain.RootChainEpochPrepared) {
 	env.lock.Lock()
-	defer env.lock.Unlock()
-	env.Completed = completed
+	env.EpochNumber = new(big.Int).Set(payload.EpochNumber)
+	env.Completed = false
+	env.Waiting = false
+	env.IsRequest = false
+	env.UserActivated = false
+	if payload.IsRequest {
+		env.ORBepochLength = big.NewInt(0)
+		if !payload.EpochIsEmpty {
+			env.IsRequest = true
+			env.UserActivated = payload.UserActivated
+			env.ORBepochLength = new(big.Int).Add(new(big.Int).Sub(payload.EndBlockNumber, payload.StartBlockNumber), big.NewInt(1))
+		}
+	}
+	status := env.status()
+	env.lock.Unlock()
+
+	env.postStatus(status)
 }
 
-func (env *EpochEnvironment) setIsRequest(IsRequest bool) {
+// blockMined counts a block mined in the current epoch, and marks the epoch as
+// completed if it has reached its length.
+func (env *EpochEnvironment) blockMined() {
 	env.lock.Lock()
-	defer env.lock.Unlock()
-	env.IsRequest = IsRequest
+	if env.IsRequest {
+		env.NumORBmined = new(big.Int).Add(env.NumORBmined, big.NewInt(1))
+	} else {
+		env.NumNRBmined = new(big.Int).Add(env.NumNRBmined, big.NewInt(1))
+	}
+	if env.NumNRBmined.Cmp(env.NRBepochLength) == 0 {
+		env.Completed = true
+	} else if env.NumORBmined.Cmp(env.ORBepochLength) == 0 && env.IsRequest {
+		env.Completed = true
+	}
+	status := env.status()
+	env.lock.Unlock()
+
+	env.postStatus(status)
 }
-func (env *EpochEnvironment) setNumNRBmined(NumNRBmined *big.Int) {
+
+// completeEpoch resets the block counter of a completed epoch and gates mining
+// until the next epoch is prepared. It reports whether the epoch was completed
+// and not gated yet.
+func (env *EpochEnvironment) completeEpoch() bool {
 	env.lock.Lock()
-	defer env.lock.Unlock()
-	env.NumNRBmined = NumNRBmined
+	if !env.Completed || env.Waiting {
+		env.lock.Unlock()
+		return false
+	}
+	if env.IsRequest {
+		env.NumORBmined = big.NewInt(0)
+	} else {
+		env.NumNRBmined = big.NewInt(0)
+	}
+	env.Waiting = true
+	status := env.status()
+	env.lock.Unlock()
+
+	env.postStatus(status)
+	return true
 }
-func (env *EpochEnvironment) setNumORBmined(NumORBmined *big.Int) {
+
+// reset clears the block counters and gates mining until the next epoch is
+// prepared.
+func (env *EpochEnvironment) reset() {
 	env.lock.Lock()
-	defer env.lock.Unlock()
-	env.NumORBmined = NumORBmined
+	env.IsRequest = false
+	env.UserActivated = false
+	env.NumNRBmined = big.NewInt(0)
+	env.NumORBmined = big.NewInt(0)
+	env.ORBepochLength = big.NewInt(0)
+	env.Completed = false
+	env.Waiting = true
+	status := env.status()
+	env.lock.Unlock()
+
+	env.postStatus(status)
 }
+
 func (env *EpochEnvironment) setNRBepochLength(NRBepochLength *big.Int) {
 	env.lock.Lock()
 	defer env.lock.Unlock()
 	env.NRBepochLength = NRBepochLength
 }
-func (env *EpochEnvironment) setORBepochLength(ORBepochLength *big.Int) {
-	env.lock.Lock()
-	defer env.lock.Unlock()
-	env.ORBepochLength = ORBepochLength
-}
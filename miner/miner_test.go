@@ -0,0 +1,95 @@
+package miner
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/event"
+)
+
+func TestEpochEnvironment(t *testing.T) {
+	mux := new(event.TypeMux)
+	sub := mux.Subscribe(EpochStatusChanged{})
+	defer sub.Unsubscribe()
+
+	env := NewEpochEnvironment()
+	env.mux = mux
+	env.setNRBepochLength(big.NewInt(2))
+
+	if status := env.Status(); !status.Waiting || status.Type != NRE {
+		t.Fatalf("initial status mismatch: %+v", status)
+	}
+
+	var events []EpochStatus
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for ev := range sub.Chan() {
+			events = append(events, ev.Data.(EpochStatusChanged).Status)
+			if len(events) == 9 {
+				return
+			}
+		}
+	}()
+
+	// NRE of 2 blocks
+	if env.ClosesEpoch() {
+		t.Fatal("block closes epoch before it is prepared")
+	}
+	env.prepareEpoch(&rootchain.RootChainEpochPrepared{EpochNumber: big.NewInt(1)})
+	if env.ClosesEpoch() {
+		t.Fatal("first block closes NRE of 2 blocks")
+	}
+	env.blockMined()
+	if !env.ClosesEpoch() {
+		t.Fatal("last block doesn't close NRE")
+	}
+	env.blockMined()
+	if env.ClosesEpoch() {
+		t.Fatal("block closes completed NRE")
+	}
+	if !env.completeEpoch() {
+		t.Fatal("completed NRE not completed")
+	}
+	if env.completeEpoch() {
+		t.Fatal("NRE completed twice")
+	}
+
+	// URE of 3 blocks
+	env.prepareEpoch(&rootchain.RootChainEpochPrepared{
+		EpochNumber:      big.NewInt(2),
+		StartBlockNumber: big.NewInt(3),
+		EndBlockNumber:   big.NewInt(5),
+		IsRequest:        true,
+		UserActivated:    true,
+	})
+	env.blockMined()
+	env.blockMined()
+	env.blockMined()
+	env.completeEpoch()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("status events missing: have %d, want 9", len(events))
+	}
+
+	want := []EpochStatus{
+		{EpochNumber: 1, Type: NRE, NumMined: 0, Length: 2},
+		{EpochNumber: 1, Type: NRE, NumMined: 1, Length: 2},
+		{EpochNumber: 1, Type: NRE, NumMined: 2, Length: 2, Completed: true},
+		{EpochNumber: 1, Type: NRE, NumMined: 0, Length: 2, Completed: true, Waiting: true},
+		{EpochNumber: 2, Type: URE, IsRequest: true, UserActivated: true, NumMined: 0, Length: 3},
+		{EpochNumber: 2, Type: URE, IsRequest: true, UserActivated: true, NumMined: 1, Length: 3},
+		{EpochNumber: 2, Type: URE, IsRequest: true, UserActivated: true, NumMined: 2, Length: 3},
+		{EpochNumber: 2, Type: URE, IsRequest: true, UserActivated: true, NumMined: 3, Length: 3, Completed: true},
+		{EpochNumber: 2, Type: URE, IsRequest: true, UserActivated: true, NumMined: 0, Length: 3, Completed: true, Waiting: true},
+	}
+	for i := range want {
+		if events[i] != want[i] {
+			t.Errorf("event #%d mismatch: have %+v, want %+v", i, events[i], want[i])
+		}
+	}
+}
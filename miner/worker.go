@@ -353,7 +353,7 @@ func (w *worker) newWorkLoop(recommit time.Duration) {
 
 		case head := <-w.chainHeadCh:
 			// commit new mining work again only when the epoch is not completed.
-			if w.env.Completed == false {
+			if !w.env.isCompleted() {
 				clearPending(head.Block.NumberU64())
 				timestamp = time.Now().Unix()
 				commit(true, commitInterruptNewHead)
@@ -417,7 +417,7 @@ func (w *worker) mainLoop() {
 	for {
 		select {
 		case req := <-w.newWorkCh:
-			if w.env.IsRequest {
+			if w.env.isRequest() {
 				w.commitNewWorkForORB(req.interrupt, req.noempty, req.timestamp)
 			} else {
 				w.commitNewWork(req.interrupt, req.noempty, req.timestamp)
@@ -598,19 +598,8 @@ func (w *worker) resultLoop() {
 			log.Info("Successfully sealed new block", "number", block.Number(), "sealhash", sealhash, "hash", hash,
 				"elapsed", common.PrettyDuration(time.Since(task.createdAt)))
 
-			// add 1 to number of block mined
-			if w.env.IsRequest {
-				w.env.setNumORBmined(new(big.Int).Add(w.env.NumORBmined, big.NewInt(1)))
-			} else {
-				w.env.setNumNRBmined(new(big.Int).Add(w.env.NumNRBmined, big.NewInt(1)))
-			}
-
-			// check if the epoch is completed
-			if w.env.NumNRBmined.Cmp(w.env.NRBepochLength) == 0 {
-				w.env.setCompleted(true)
-			} else if w.env.NumORBmined.Cmp(w.env.ORBepochLength) == 0 && w.env.IsRequest {
-				w.env.setCompleted(true)
-			}
+			// add 1 to number of block mined and check if the epoch is completed
+			w.env.blockMined()
 
 			// Insert the block into the set of pending ones to resultLoop for confirmations
 			w.unconfirmed.Insert(block.NumberU64(), block.Hash())
@@ -1024,8 +1013,13 @@ func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64)
 		log.Error("Failed to fetch pending transactions", "err", err)
 		return
 	}
-	// Short circuit if there is no available pending transactions
+	// Short circuit if there is no available pending transactions, unless an
+	// empty plasma block is needed to complete the epoch
 	if len(pending) == 0 {
+		if w.config.CliquePlasma != nil && w.env.ClosesEpoch() {
+			w.commit(uncles, nil, true, tstart)
+			return
+		}
 		w.updateSnapshot()
 		return
 	}
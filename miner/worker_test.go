@@ -104,6 +104,10 @@ func newTestWorkerBackend(t *testing.T, chainConfig *params.ChainConfig, engine
 		blocks, _ := core.GenerateChain(chainConfig, genesis, engine, db, n, func(i int, gen *core.BlockGen) {
 			gen.SetCoinbase(testBankAddress)
 		})
+		// The plasma chain derives the total difficulty of a block when sealing it
+		for i, block := range blocks {
+			blocks[i] = block.WithSeal(block.Header())
+		}
 		if _, err := chain.InsertChain(blocks); err != nil {
 			t.Fatalf("failed to insert origin chain: %v", err)
 		}
@@ -133,7 +137,7 @@ func (b *testWorkerBackend) PostChainEvents(events []interface{}) {
 func newTestWorker(t *testing.T, chainConfig *params.ChainConfig, engine consensus.Engine, blocks int) (*worker, *testWorkerBackend) {
 	backend := newTestWorkerBackend(t, chainConfig, engine, blocks)
 	backend.txPool.AddLocals(pendingTxs)
-	w := newWorker(chainConfig, engine, backend, new(event.TypeMux), time.Second, params.GenesisGasLimit, params.GenesisGasLimit, nil)
+	w := newWorker(chainConfig, engine, backend, NewEpochEnvironment(), new(event.TypeMux), time.Second, params.GenesisGasLimit, params.GenesisGasLimit, nil)
 	w.setEtherbase(testBankAddress)
 	return w, backend
 }
@@ -183,28 +187,18 @@ func testEmptyWork(t *testing.T, chainConfig *params.ChainConfig, engine consens
 	w, _ := newTestWorker(t, chainConfig, engine, 0)
 	defer w.close()
 
-	var (
-		taskCh    = make(chan struct{}, 2)
-		taskIndex int
-	)
-
-	checkEqual := func(t *testing.T, task *task, index int) {
-		receiptLen, balance := 0, big.NewInt(0)
-		if index == 1 {
-			receiptLen, balance = 1, big.NewInt(1000)
-		}
-		if len(task.receipts) != receiptLen {
-			t.Errorf("receipt number mismatch: have %d, want %d", len(task.receipts), receiptLen)
-		}
-		if task.state.GetBalance(testUserAddress).Cmp(balance) != 0 {
-			t.Errorf("account balance mismatch: have %d, want %d", task.state.GetBalance(testUserAddress), balance)
-		}
-	}
+	var taskCh = make(chan struct{}, 2)
 
+	// Plasma blocks are never sealed empty ahead of the pending transactions,
+	// so the only task of block#1 is the full one.
 	w.newTaskHook = func(task *task) {
 		if task.block.NumberU64() == 1 {
-			checkEqual(t, task, taskIndex)
-			taskIndex += 1
+			if len(task.receipts) != 1 {
+				t.Errorf("receipt number mismatch: have %d, want %d", len(task.receipts), 1)
+			}
+			if balance := task.state.GetBalance(testUserAddress); balance.Cmp(big.NewInt(1000)) != 0 {
+				t.Errorf("account balance mismatch: have %d, want %d", balance, 1000)
+			}
 			taskCh <- struct{}{}
 		}
 	}
@@ -221,12 +215,15 @@ func testEmptyWork(t *testing.T, chainConfig *params.ChainConfig, engine consens
 	}
 
 	w.start()
-	for i := 0; i < 2; i += 1 {
-		select {
-		case <-taskCh:
-		case <-time.NewTimer(time.Second).C:
-			t.Error("new task timeout")
-		}
+	select {
+	case <-taskCh:
+	case <-time.NewTimer(time.Second).C:
+		t.Error("new task timeout")
+	}
+	select {
+	case <-taskCh:
+		t.Error("unexpected empty task")
+	case <-time.NewTimer(200 * time.Millisecond).C:
 	}
 }
 
@@ -242,7 +239,7 @@ func TestStreamUncleBlock(t *testing.T) {
 	taskIndex := 0
 	w.newTaskHook = func(task *task) {
 		if task.block.NumberU64() == 2 {
-			if taskIndex == 2 {
+			if taskIndex == 1 {
 				have := task.block.Header().UncleHash
 				want := types.CalcUncleHash([]*types.Header{b.uncleBlock.Header()})
 				if have != want {
@@ -269,13 +266,11 @@ func TestStreamUncleBlock(t *testing.T) {
 	}
 	w.start()
 
-	// Ignore the first two works
-	for i := 0; i < 2; i += 1 {
-		select {
-		case <-taskCh:
-		case <-time.NewTimer(time.Second).C:
-			t.Error("new task timeout")
-		}
+	// Ignore the first work, plasma blocks are not sealed empty in advance
+	select {
+	case <-taskCh:
+	case <-time.NewTimer(time.Second).C:
+		t.Error("new task timeout")
 	}
 	b.PostChainEvents([]interface{}{core.ChainSideEvent{Block: b.uncleBlock}})
 
@@ -305,7 +300,7 @@ func testRegenerateMiningBlock(t *testing.T, chainConfig *params.ChainConfig, en
 	taskIndex := 0
 	w.newTaskHook = func(task *task) {
 		if task.block.NumberU64() == 1 {
-			if taskIndex == 2 {
+			if taskIndex == 1 {
 				receiptLen, balance := 2, big.NewInt(2000)
 				if len(task.receipts) != receiptLen {
 					t.Errorf("receipt number mismatch: have %d, want %d", len(task.receipts), receiptLen)
@@ -333,13 +328,11 @@ func testRegenerateMiningBlock(t *testing.T, chainConfig *params.ChainConfig, en
 	}
 
 	w.start()
-	// Ignore the first two works
-	for i := 0; i < 2; i += 1 {
-		select {
-		case <-taskCh:
-		case <-time.NewTimer(time.Second).C:
-			t.Error("new task timeout")
-		}
+	// Ignore the first work, plasma blocks are not sealed empty in advance
+	select {
+	case <-taskCh:
+	case <-time.NewTimer(time.Second).C:
+		t.Error("new task timeout")
 	}
 	b.txPool.AddLocals(newTxs)
 	time.Sleep(time.Second)
@@ -22,7 +22,7 @@ import (
 	"math/big"
 
 	"github.com/Onther-Tech/plasma-evm/core/types"
-	"github.com/Onther-Tech/plasma-evm/plsclient"
+	"github.com/Onther-Tech/plasma-evm/ethclient"
 )
 
 // EthereumClient provides access to the Ethereum APIs.
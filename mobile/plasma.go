@@ -0,0 +1,354 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Contains a wrapper for the plasma chain and its RootChain contract.
+
+package geth
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/Onther-Tech/plasma-evm/accounts/abi/bind"
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/ethclient"
+	"github.com/Onther-Tech/plasma-evm/plsclient"
+)
+
+// PlasmaClient provides access to a plasma chain and to the RootChain contract
+// anchoring it, allowing wallets to bridge funds between the two chains.
+type PlasmaClient struct {
+	client    *plsclient.Client    // Client connection to the plasma chain
+	rootchain *ethclient.Client    // Client connection to the rootchain
+	address   common.Address       // Address of the RootChain contract
+	contract  *rootchain.RootChain // Binding to the RootChain contract
+}
+
+// NewPlasmaClient connects a client to the plasma chain at the given URL and to
+// the RootChain contract at the given address on the rootchain.
+func NewPlasmaClient(rawurl string, rootchainURL string, contract *Address) (client *PlasmaClient, _ error) {
+	rawClient, err := plsclient.Dial(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	rawRootchain, err := ethclient.Dial(rootchainURL)
+	if err != nil {
+		rawClient.Close()
+		return nil, err
+	}
+	rawContract, err := rootchain.NewRootChain(contract.address, rawRootchain)
+	if err != nil {
+		rawClient.Close()
+		rawRootchain.Close()
+		return nil, err
+	}
+	return &PlasmaClient{rawClient, rawRootchain, contract.address, rawContract}, nil
+}
+
+// Requests
+
+// StartEnter creates an enter request on the RootChain contract. Ether is moved
+// to the given account with a transfer request, otherwise the request is applied
+// to the given requestable contract with the trie key and value.
+func (pc *PlasmaClient) StartEnter(opts *TransactOpts, isTransfer bool, to *Address, trieKey *Hash, trieValue *Hash) (tx *Transaction, _ error) {
+	rawTx, err := pc.contract.StartEnter(&opts.opts, isTransfer, to.address, trieKey.hash, trieValue.hash)
+	if err != nil {
+		return nil, err
+	}
+	return &Transaction{rawTx}, nil
+}
+
+// StartExit creates an exit request on the RootChain contract for the given
+// requestable contract. The exit cost must be paid as the transaction value.
+func (pc *PlasmaClient) StartExit(opts *TransactOpts, to *Address, trieKey *Hash, trieValue *Hash) (tx *Transaction, _ error) {
+	rawTx, err := pc.contract.StartExit(&opts.opts, to.address, trieKey.hash, trieValue.hash)
+	if err != nil {
+		return nil, err
+	}
+	return &Transaction{rawTx}, nil
+}
+
+// GetExitCost returns the wei cost of creating an exit request.
+func (pc *PlasmaClient) GetExitCost(ctx *Context) (cost *BigInt, _ error) {
+	rawCost, err := pc.contract.COSTERO(&bind.CallOpts{Context: ctx.context})
+	return &BigInt{rawCost}, err
+}
+
+// PlasmaRequest represents an enter or exit request stored in the RootChain
+// contract.
+type PlasmaRequest struct {
+	timestamp  uint64
+	isExit     bool
+	isTransfer bool
+	finalized  bool
+	challenged bool
+	value      *big.Int
+	requestor  common.Address
+	to         common.Address
+	trieKey    common.Hash
+	trieValue  common.Hash
+}
+
+func (r *PlasmaRequest) GetTimestamp() int64    { return int64(r.timestamp) }
+func (r *PlasmaRequest) IsExit() bool           { return r.isExit }
+func (r *PlasmaRequest) IsTransfer() bool       { return r.isTransfer }
+func (r *PlasmaRequest) IsFinalized() bool      { return r.finalized }
+func (r *PlasmaRequest) IsChallenged() bool     { return r.challenged }
+func (r *PlasmaRequest) GetValue() *BigInt      { return &BigInt{r.value} }
+func (r *PlasmaRequest) GetRequestor() *Address { return &Address{r.requestor} }
+func (r *PlasmaRequest) GetTo() *Address        { return &Address{r.to} }
+func (r *PlasmaRequest) GetTrieKey() *Hash      { return &Hash{r.trieKey} }
+func (r *PlasmaRequest) GetTrieValue() *Hash    { return &Hash{r.trieValue} }
+
+// GetRequest returns the enter or exit request with the given identifier. User
+// activated requests are looked up among the requests of user submitted blocks.
+func (pc *PlasmaClient) GetRequest(ctx *Context, requestId int64, userActivated bool) (request *PlasmaRequest, _ error) {
+	opts := &bind.CallOpts{Context: ctx.context}
+
+	getRequest := pc.contract.EROs
+	if userActivated {
+		getRequest = pc.contract.ERUs
+	}
+	rawRequest, err := getRequest(opts, big.NewInt(requestId))
+	if err != nil {
+		return nil, err
+	}
+	return &PlasmaRequest{
+		timestamp:  rawRequest.Timestamp,
+		isExit:     rawRequest.IsExit,
+		isTransfer: rawRequest.IsTransfer,
+		finalized:  rawRequest.Finalized,
+		challenged: rawRequest.Challenged,
+		value:      rawRequest.Value,
+		requestor:  rawRequest.Requestor,
+		to:         rawRequest.To,
+		trieKey:    rawRequest.TrieKey,
+		trieValue:  rawRequest.TrieValue,
+	}, nil
+}
+
+// IsRequestFinalized returns whether the given request is finalized.
+func (pc *PlasmaClient) IsRequestFinalized(ctx *Context, requestId int64, userActivated bool) (finalized bool, _ error) {
+	return pc.contract.GetRequestFinalized(&bind.CallOpts{Context: ctx.context}, big.NewInt(requestId), userActivated)
+}
+
+// Epochs and finality
+
+// GetCurrentFork returns the current fork number of the plasma chain.
+func (pc *PlasmaClient) GetCurrentFork(ctx *Context) (fork int64, _ error) {
+	rawFork, err := pc.contract.CurrentFork(&bind.CallOpts{Context: ctx.context})
+	if err != nil {
+		return 0, err
+	}
+	return rawFork.Int64(), nil
+}
+
+// GetLastBlock returns the number of the last block submitted in the given fork.
+func (pc *PlasmaClient) GetLastBlock(ctx *Context, fork int64) (number int64, _ error) {
+	rawNumber, err := pc.contract.LastBlock(&bind.CallOpts{Context: ctx.context}, big.NewInt(fork))
+	if err != nil {
+		return 0, err
+	}
+	return rawNumber.Int64(), nil
+}
+
+// GetLastFinalizedBlock returns the number of the last block finalized in the
+// given fork.
+func (pc *PlasmaClient) GetLastFinalizedBlock(ctx *Context, fork int64) (number int64, _ error) {
+	rawNumber, err := pc.contract.GetLastFinalizedBlock(&bind.CallOpts{Context: ctx.context}, big.NewInt(fork))
+	if err != nil {
+		return 0, err
+	}
+	return rawNumber.Int64(), nil
+}
+
+// PlasmaEpoch represents an epoch of the plasma chain stored in the RootChain
+// contract.
+type PlasmaEpoch struct {
+	requestStart     uint64
+	requestEnd       uint64
+	startBlockNumber uint64
+	endBlockNumber   uint64
+	isEmpty          bool
+	isRequest        bool
+	userActivated    bool
+	rebase           bool
+}
+
+func (e *PlasmaEpoch) GetRequestStart() int64     { return int64(e.requestStart) }
+func (e *PlasmaEpoch) GetRequestEnd() int64       { return int64(e.requestEnd) }
+func (e *PlasmaEpoch) GetStartBlockNumber() int64 { return int64(e.startBlockNumber) }
+func (e *PlasmaEpoch) GetEndBlockNumber() int64   { return int64(e.endBlockNumber) }
+func (e *PlasmaEpoch) IsEmpty() bool              { return e.isEmpty }
+func (e *PlasmaEpoch) IsRequest() bool            { return e.isRequest }
+func (e *PlasmaEpoch) IsUserActivated() bool      { return e.userActivated }
+func (e *PlasmaEpoch) IsRebase() bool             { return e.rebase }
+
+// GetEpoch returns the given epoch of the given fork.
+func (pc *PlasmaClient) GetEpoch(ctx *Context, fork int64, epoch int64) (*PlasmaEpoch, error) {
+	rawEpoch, err := pc.contract.GetEpoch(&bind.CallOpts{Context: ctx.context}, big.NewInt(fork), big.NewInt(epoch))
+	if err != nil {
+		return nil, err
+	}
+	if !rawEpoch.Initialized {
+		return nil, errors.New("epoch not found")
+	}
+	return &PlasmaEpoch{
+		requestStart:     rawEpoch.RequestStart,
+		requestEnd:       rawEpoch.RequestEnd,
+		startBlockNumber: rawEpoch.StartBlockNumber,
+		endBlockNumber:   rawEpoch.EndBlockNumber,
+		isEmpty:          rawEpoch.IsEmpty,
+		isRequest:        rawEpoch.IsRequest,
+		userActivated:    rawEpoch.UserActivated,
+		rebase:           rawEpoch.Rebase,
+	}, nil
+}
+
+// BlockFinalizedHandler is a client-side subscription callback to invoke on
+// plasma blocks finalized in the RootChain contract.
+type BlockFinalizedHandler interface {
+	OnBlockFinalized(fork int64, number int64)
+	OnError(failure string)
+}
+
+// SubscribeBlockFinalized subscribes to notifications about plasma blocks being
+// finalized in the RootChain contract.
+func (pc *PlasmaClient) SubscribeBlockFinalized(ctx *Context, handler BlockFinalizedHandler, buffer int) (sub *Subscription, _ error) {
+	// Subscribe to the event internally
+	ch := make(chan *rootchain.RootChainBlockFinalized, buffer)
+	rawSub, err := pc.contract.WatchBlockFinalized(&bind.WatchOpts{Context: ctx.context}, ch)
+	if err != nil {
+		return nil, err
+	}
+	// Start up a dispatcher to feed into the callback
+	go func() {
+		for {
+			select {
+			case event := <-ch:
+				handler.OnBlockFinalized(event.ForkNumber.Int64(), event.BlockNumber.Int64())
+
+			case err := <-rawSub.Err():
+				if err != nil {
+					handler.OnError(err.Error())
+				}
+				return
+			}
+		}
+	}()
+	return &Subscription{rawSub}, nil
+}
+
+// RequestFinalizedHandler is a client-side subscription callback to invoke on
+// enter and exit requests finalized in the RootChain contract.
+type RequestFinalizedHandler interface {
+	OnRequestFinalized(requestId int64, userActivated bool)
+	OnError(failure string)
+}
+
+// SubscribeRequestFinalized subscribes to notifications about enter and exit
+// requests being finalized in the RootChain contract.
+func (pc *PlasmaClient) SubscribeRequestFinalized(ctx *Context, handler RequestFinalizedHandler, buffer int) (sub *Subscription, _ error) {
+	// Subscribe to the event internally
+	ch := make(chan *rootchain.RootChainRequestFinalized, buffer)
+	rawSub, err := pc.contract.WatchRequestFinalized(&bind.WatchOpts{Context: ctx.context}, ch)
+	if err != nil {
+		return nil, err
+	}
+	// Start up a dispatcher to feed into the callback
+	go func() {
+		for {
+			select {
+			case event := <-ch:
+				handler.OnRequestFinalized(event.RequestId.Int64(), event.UserActivated)
+
+			case err := <-rawSub.Err():
+				if err != nil {
+					handler.OnError(err.Error())
+				}
+				return
+			}
+		}
+	}()
+	return &Subscription{rawSub}, nil
+}
+
+// Proofs
+
+// AccountProof is the Merkle proof of an account and some of its storage slots
+// on the plasma chain, as needed to back an exit.
+type AccountProof struct {
+	result *plsclient.AccountResult
+}
+
+func (p *AccountProof) GetAddress() *Address      { return &Address{p.result.Address} }
+func (p *AccountProof) GetBalance() *BigInt       { return &BigInt{p.result.Balance.ToInt()} }
+func (p *AccountProof) GetNonce() int64           { return int64(p.result.Nonce) }
+func (p *AccountProof) GetCodeHash() *Hash        { return &Hash{p.result.CodeHash} }
+func (p *AccountProof) GetStorageHash() *Hash     { return &Hash{p.result.StorageHash} }
+func (p *AccountProof) GetAccountProof() *Strings { return &Strings{p.result.AccountProof} }
+func (p *AccountProof) GetStorageProofSize() int  { return len(p.result.StorageProof) }
+
+// GetStorageProof returns the Merkle proof of the storage slot at the given
+// index of the requested keys.
+func (p *AccountProof) GetStorageProof(index int) (proof *StorageProof, _ error) {
+	if index < 0 || index >= len(p.result.StorageProof) {
+		return nil, errors.New("index out of bounds")
+	}
+	return &StorageProof{&p.result.StorageProof[index]}, nil
+}
+
+// EncodeJSON encodes an account proof into an EIP-1186 JSON data dump.
+func (p *AccountProof) EncodeJSON() (string, error) {
+	data, err := json.Marshal(p.result)
+	return string(data), err
+}
+
+// StorageProof is the Merkle proof of a single storage slot.
+type StorageProof struct {
+	result *plsclient.StorageResult
+}
+
+func (p *StorageProof) GetKey() string     { return p.result.Key }
+func (p *StorageProof) GetValue() *BigInt  { return &BigInt{p.result.Value.ToInt()} }
+func (p *StorageProof) GetProof() *Strings { return &Strings{p.result.Proof} }
+
+// GetProof returns the Merkle proof of the given account and storage keys on the
+// plasma chain. The block number can be <0, in which case the proof is taken from
+// the latest known block.
+func (pc *PlasmaClient) GetProof(ctx *Context, account *Address, keys *Hashes, number int64) (proof *AccountProof, _ error) {
+	var rawNumber *big.Int
+	if number >= 0 {
+		rawNumber = big.NewInt(number)
+	}
+	var rawKeys []common.Hash
+	if keys != nil {
+		rawKeys = keys.hashes
+	}
+	result, err := pc.client.GetProof(ctx.context, account.address, rawKeys, rawNumber)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountProof{result}, nil
+}
+
+// VerifyProof checks the Merkle proof against the state root the RootChain
+// contract holds for the given finalized block of the given fork.
+func (pc *PlasmaClient) VerifyProof(ctx *Context, proof *AccountProof, fork int64, number int64) error {
+	return plsclient.VerifyProof(ctx.context, pc.rootchain, pc.address, big.NewInt(fork), big.NewInt(number), proof.result)
+}
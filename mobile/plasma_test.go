@@ -0,0 +1,60 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package geth
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/Onther-Tech/plasma-evm/accounts/abi"
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/internal/rootchaintest"
+)
+
+// Tests that the exit cost quoted to wallets is the cost of the exit requests
+// StartExit creates, not of the user activated ones.
+func TestPlasmaExitCost(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(rootchain.RootChainABI))
+	if err != nil {
+		t.Fatalf("failed to parse RootChain ABI: %v", err)
+	}
+	backend := rootchaintest.NewBackend(parsed)
+	for method, cost := range map[string]int64{"COST_ERO": 100, "COST_ERU": 200} {
+		cost := big.NewInt(cost)
+		backend.Handle(method, func([]interface{}) ([]interface{}, error) {
+			return []interface{}{cost}, nil
+		})
+	}
+	client := backend.Client()
+	defer client.Close()
+
+	contract, err := rootchain.NewRootChain(common.HexToAddress("0x0100000000000000000000000000000000000000"), client)
+	if err != nil {
+		t.Fatalf("failed to bind RootChain contract: %v", err)
+	}
+	pc := &PlasmaClient{rootchain: client, contract: contract}
+
+	cost, err := pc.GetExitCost(NewContext())
+	if err != nil {
+		t.Fatalf("failed to retrieve exit cost: %v", err)
+	}
+	if cost.GetInt64() != 100 {
+		t.Errorf("exit cost mismatch: have %d, want %d", cost.GetInt64(), 100)
+	}
+}
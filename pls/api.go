@@ -26,6 +26,7 @@ import (
 	"os"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Onther-Tech/plasma-evm/common"
@@ -35,6 +36,7 @@ import (
 	"github.com/Onther-Tech/plasma-evm/core/state"
 	"github.com/Onther-Tech/plasma-evm/core/types"
 	"github.com/Onther-Tech/plasma-evm/internal/ethapi"
+	"github.com/Onther-Tech/plasma-evm/miner"
 	"github.com/Onther-Tech/plasma-evm/params"
 	"github.com/Onther-Tech/plasma-evm/rlp"
 	"github.com/Onther-Tech/plasma-evm/rpc"
@@ -155,10 +157,131 @@ func (api *PrivateMinerAPI) GetHashrate() uint64 {
 	return api.p.miner.HashRate()
 }
 
+// EpochStatus returns the epoch the miner is in, the number of blocks mined in
+// it and whether mining is waiting for the next epoch to be prepared.
+func (api *PrivateMinerAPI) EpochStatus() miner.EpochStatus {
+	return api.p.miner.EpochStatus()
+}
+
+// PublicRootChainAPI provides an API to access the state of the plasma chain
+// against the RootChain contract.
+type PublicRootChainAPI struct {
+	pls *Plasma
+}
+
+// NewPublicRootChainAPI creates a new API definition for the rootchain related
+// methods of the Plasma service.
+func NewPublicRootChainAPI(pls *Plasma) *PublicRootChainAPI {
+	return &PublicRootChainAPI{pls: pls}
+}
+
+// WithholdingStatus returns the submitted blocks which are not available locally
+// and the exits prepared for them.
+func (api *PublicRootChainAPI) WithholdingStatus() *WithholdingStatus {
+	return api.pls.rootchainManager.WithholdingStatus()
+}
+
+// GetExitRequests returns the exit requests for the ETH balance and the
+// requestable token balances of the requestor at a finalized block. If the
+// block number is omitted, the last finalized block is used.
+func (api *PublicRootChainAPI) GetExitRequests(requestor common.Address, blockNumber *hexutil.Uint64) (*ExitRequests, error) {
+	var number *uint64
+	if blockNumber != nil {
+		n := uint64(*blockNumber)
+		number = &n
+	}
+	return api.pls.rootchainManager.exitRequests(requestor, number)
+}
+
+// GetRootchainEvents returns the RootChain contract events matching the filter
+// from the local event index, in the order they were emitted on the rootchain.
+func (api *PublicRootChainAPI) GetRootchainEvents(filter RootChainEventFilter) ([]*RootChainEvent, error) {
+	return api.pls.rootchainEvents.events(filter)
+}
+
+// GetFailedRequests returns the reverted request transactions of the request
+// blocks in the given block range submitted to the fork. Failed exits that can
+// still be challenged are flagged as exit challenge candidates.
+func (api *PublicRootChainAPI) GetFailedRequests(fork hexutil.Uint64, fromBlock hexutil.Uint64, toBlock hexutil.Uint64) ([]*FailedRequest, error) {
+	return api.pls.rootchainManager.failedRequests(uint64(fork), uint64(fromBlock), uint64(toBlock))
+}
+
+// CurrentEpoch returns the last epoch of the current fork on the RootChain
+// contract.
+func (api *PublicRootChainAPI) CurrentEpoch() (*RPCEpoch, error) {
+	return api.pls.rootchainManager.currentEpoch()
+}
+
+// GetPlasmaBlock returns the given block as submitted to the current fork of
+// the RootChain contract, and whether it matches the local block.
+func (api *PublicRootChainAPI) GetPlasmaBlock(number hexutil.Uint64) (*RPCPlasmaBlock, error) {
+	return api.pls.rootchainManager.plasmaBlock(uint64(number))
+}
+
+// PendingRequests returns the enter and exit requests of the operator request
+// blocks which are not finalized yet, oldest first.
+func (api *PublicRootChainAPI) PendingRequests() ([]*PendingRequest, error) {
+	return api.pls.rootchainManager.PendingRequests()
+}
+
+// RequestableContracts returns the current mappings of the requestable contracts
+// used by requests so far or mapped through this node.
+func (api *PublicRootChainAPI) RequestableContracts() ([]*RequestableContract, error) {
+	return api.pls.rootchainManager.requestableContractList(rawdb.ReadRequestableContracts(api.pls.ChainDb()))
+}
+
+// OperatorStatus is the status of the plasma operator as reported over RPC.
+type OperatorStatus struct {
+	Operator           common.Address `json:"operator"`
+	Balance            *hexutil.Big   `json:"balance"`
+	Mining             bool           `json:"mining"`
+	CurrentBlock       hexutil.Uint64 `json:"currentBlock"`
+	CurrentFork        hexutil.Uint64 `json:"currentFork"`
+	CurrentEpoch       hexutil.Uint64 `json:"currentEpoch"`
+	EpochType          string         `json:"epochType"`
+	LastSubmittedBlock hexutil.Uint64 `json:"lastSubmittedBlock"`
+	LastFinalizedBlock hexutil.Uint64 `json:"lastFinalizedBlock"`
+	PendingEnters      int            `json:"pendingEnters"`
+	PendingExits       int            `json:"pendingExits"`
+	SubmissionLatency  string         `json:"submissionLatency"`
+	LastSubmission     *Submission    `json:"lastSubmission"`
+}
+
+// OperatorStatus returns the status of the operator on the RootChain contract
+// and of its block production.
+func (api *PublicRootChainAPI) OperatorStatus() (*OperatorStatus, error) {
+	rcm := api.pls.rootchainManager
+
+	status, err := rcm.Status()
+	if err != nil {
+		return nil, err
+	}
+	result := &OperatorStatus{
+		Operator:           rcm.config.Operator.Address,
+		Balance:            (*hexutil.Big)(status.OperatorBalance),
+		Mining:             api.pls.IsMining(),
+		CurrentBlock:       hexutil.Uint64(api.pls.blockchain.CurrentBlock().NumberU64()),
+		CurrentFork:        hexutil.Uint64(status.CurrentFork),
+		CurrentEpoch:       hexutil.Uint64(status.CurrentEpoch),
+		EpochType:          status.EpochType,
+		LastSubmittedBlock: hexutil.Uint64(status.LastSubmittedBlock),
+		LastFinalizedBlock: hexutil.Uint64(status.LastFinalizedBlock),
+		PendingEnters:      status.PendingEnters,
+		PendingExits:       status.PendingExits,
+		SubmissionLatency:  status.SubmissionLatency.String(),
+	}
+	if subs := rcm.Submissions(); len(subs) > 0 {
+		result.LastSubmission = subs[len(subs)-1]
+	}
+	return result, nil
+}
+
 // PrivateAdminAPI is the collection of Ethereum full node-related APIs
 // exposed over the private admin endpoint.
 type PrivateAdminAPI struct {
 	pls *Plasma
+
+	mappingLock sync.Mutex // Serializes requestable contract mappings
 }
 
 // NewPrivateAdminAPI creates a new API definition for the full node private
@@ -189,6 +312,24 @@ func (api *PrivateAdminAPI) ExportChain(file string) (bool, error) {
 	return true, nil
 }
 
+// MapRequestableContract maps the requestable contract at root on the rootchain
+// to child on the plasma chain. The child contract must implement
+// applyRequestInChildChain and apply request transactions on the current plasma
+// state before the mapping is submitted by the operator. It returns the hash of
+// the mined mapping transaction.
+func (api *PrivateAdminAPI) MapRequestableContract(ctx context.Context, root, child common.Address) (common.Hash, error) {
+	api.mappingLock.Lock()
+	defer api.mappingLock.Unlock()
+
+	hash, err := api.pls.rootchainManager.mapRequestableContract(ctx, root, child)
+	if err != nil {
+		return hash, err
+	}
+	db := api.pls.ChainDb()
+	rawdb.WriteRequestableContracts(db, append(rawdb.ReadRequestableContracts(db), root))
+	return hash, nil
+}
+
 func hasAllBlocks(chain *core.BlockChain, bs []*types.Block) bool {
 	for _, b := range bs {
 		if !chain.HasBlock(b.Hash(), b.NumberU64()) {
@@ -216,34 +357,38 @@ func (api *PrivateAdminAPI) ImportChain(file string) (bool, error) {
 	}
 
 	// Run actual the import in pre-configured batches
-	stream := rlp.NewStream(reader, 0)
+	stream := core.NewChainImportStream(reader)
 
-	blocks, index := make([]*types.Block, 0, 2500), 0
+	blocks, metas, index := make([]*types.Block, 0, 2500), make([]*rawdb.PlasmaBlockMeta, 0, 2500), 0
 	for batch := 0; ; batch++ {
 		// Load a batch of blocks from the input file
 		for len(blocks) < cap(blocks) {
-			block := new(types.Block)
-			if err := stream.Decode(block); err == io.EOF {
+			block, meta, err := stream.Next()
+			if err == io.EOF {
 				break
 			} else if err != nil {
 				return false, fmt.Errorf("block %d: failed to parse: %v", index, err)
 			}
-			blocks = append(blocks, block)
+			blocks, metas = append(blocks, block), append(metas, meta)
 			index++
 		}
 		if len(blocks) == 0 {
 			break
 		}
 
-		if hasAllBlocks(api.pls.BlockChain(), blocks) {
-			blocks = blocks[:0]
-			continue
+		if !hasAllBlocks(api.pls.BlockChain(), blocks) {
+			// Import the batch
+			if _, err := api.pls.BlockChain().InsertChain(blocks); err != nil {
+				return false, fmt.Errorf("batch %d: failed to insert: %v", batch, err)
+			}
 		}
-		// Import the batch and reset the buffer
-		if _, err := api.pls.BlockChain().InsertChain(blocks); err != nil {
-			return false, fmt.Errorf("batch %d: failed to insert: %v", batch, err)
+		// Restore the plasma metadata and reset the buffer
+		for i, meta := range metas {
+			if meta != nil {
+				api.pls.BlockChain().WritePlasmaBlockMeta(blocks[i].Hash(), blocks[i].NumberU64(), meta)
+			}
 		}
-		blocks = blocks[:0]
+		blocks, metas = blocks[:0], metas[:0]
 	}
 	return true, nil
 }
@@ -185,6 +185,14 @@ func (b *PlsAPIBackend) TxPoolContent() (map[common.Address]types.Transactions,
 	return b.pls.TxPool().Content()
 }
 
+func (b *PlsAPIBackend) TxPoolRequests() []types.Transactions {
+	return b.pls.TxPool().RequestContent()
+}
+
+func (b *PlsAPIBackend) RequestStats() (blocks int, txs int) {
+	return b.pls.TxPool().RequestStats()
+}
+
 func (b *PlsAPIBackend) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscription {
 	return b.pls.TxPool().SubscribeNewTxsEvent(ch)
 }
@@ -201,6 +209,10 @@ func (b *PlsAPIBackend) SuggestPrice(ctx context.Context) (*big.Int, error) {
 	return b.gpo.SuggestPrice(ctx)
 }
 
+func (b *PlsAPIBackend) SuggestPrices(ctx context.Context) (*gasprice.Suggestion, error) {
+	return b.gpo.SuggestPrices(ctx)
+}
+
 func (b *PlsAPIBackend) ChainDb() ethdb.Database {
 	return b.pls.ChainDb()
 }
@@ -707,6 +707,36 @@ func (api *PrivateDebugAPI) TraceTransaction(ctx context.Context, hash common.Ha
 	return api.traceTx(ctx, msg, vmctx, statedb, config)
 }
 
+// TraceRequest returns the trace of the request transaction applying the enter
+// or exit request with the given ID in the plasma chain. The ID is an ERU ID if
+// the request is user activated, and an ERO ID otherwise. Unless another tracer
+// is configured, the request tracer decodes the applyRequestInChildChain call
+// and reports its revert reason and the storage changes of the requestable
+// contract.
+func (api *PrivateDebugAPI) TraceRequest(ctx context.Context, requestId hexutil.Uint64, userActivated bool, config *TraceConfig) (interface{}, error) {
+	block, index, err := api.pls.rootchainManager.findRequestTx(uint64(requestId), userActivated)
+	if err != nil {
+		return nil, err
+	}
+	var cfg TraceConfig
+	if config != nil {
+		cfg = *config
+	}
+	if cfg.Tracer == nil && cfg.LogConfig == nil {
+		tracer := "requestTracer"
+		cfg.Tracer = &tracer
+	}
+	reexec := defaultTraceReexec
+	if cfg.Reexec != nil {
+		reexec = *cfg.Reexec
+	}
+	msg, vmctx, statedb, err := api.computeTxEnv(block.Hash(), index, reexec)
+	if err != nil {
+		return nil, err
+	}
+	return api.traceTx(ctx, msg, vmctx, statedb, &cfg)
+}
+
 // traceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -30,6 +30,7 @@ import (
 	"github.com/Onther-Tech/plasma-evm/common/hexutil"
 	"github.com/Onther-Tech/plasma-evm/consensus"
 	"github.com/Onther-Tech/plasma-evm/consensus/clique"
+	"github.com/Onther-Tech/plasma-evm/consensus/cliqueplasma"
 	"github.com/Onther-Tech/plasma-evm/consensus/ethash"
 	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
 	"github.com/Onther-Tech/plasma-evm/core"
@@ -74,6 +75,7 @@ type Plasma struct {
 	protocolManager  *ProtocolManager
 	lesServer        LesServer
 	rootchainManager *RootChainManager
+	rootchainEvents  *rootchainEventIndex
 
 	// DB interfaces
 	chainDb ethdb.Database // Block chain database
@@ -174,6 +176,9 @@ func New(ctx *node.ServiceContext, config *Config) (*Plasma, error) {
 	if config.TxPool.Journal != "" {
 		config.TxPool.Journal = ctx.ResolvePath(config.TxPool.Journal)
 	}
+	if config.TxPool.RequestJournal != "" {
+		config.TxPool.RequestJournal = ctx.ResolvePath(config.TxPool.RequestJournal)
+	}
 	pls.txPool = core.NewTxPool(config.TxPool, pls.chainConfig, pls.blockchain)
 
 	if pls.protocolManager, err = NewProtocolManager(pls.chainConfig, config.SyncMode, config.NetworkId, pls.eventMux, pls.txPool, pls.engine, pls.blockchain, chainDb, config.Whitelist); err != nil {
@@ -204,6 +209,30 @@ func New(ctx *node.ServiceContext, config *Config) (*Plasma, error) {
 		return nil, err
 	}
 
+	// Make sure the local genesis is the one the RootChain contract was deployed with
+	if err := VerifyRootChainGenesis(rootchainContract, config.RootChainContract, pls.blockchain.Genesis().Header()); err != nil {
+		return nil, err
+	}
+
+	// Only accept blocks signed by the operator of the RootChain contract
+	if engine, ok := pls.engine.(*cliqueplasma.Clique); ok {
+		operator, err := rootchainContract.Operator(baseCallOpt)
+		if err != nil {
+			return nil, err
+		}
+		engine.SetOperator(operator)
+		engine.SetEpochEnd(func(*types.Header) bool { return epochEnv.ClosesEpoch() })
+		log.Info("Plasma blocks verified against operator", "operator", operator)
+	}
+
+	// Pick the fast sync pivot from the RootChain contract instead of the peers
+	if config.RootChainSync {
+		pls.protocolManager.downloader.SetPivotOracle(&rootchainPivot{rootchainContract})
+		log.Info("Fast sync pivot anchored to RootChain contract")
+	}
+
+	pls.rootchainEvents = newRootchainEventIndex(chainDb, rootchainBackend, config.RootChainContract)
+
 	stopFn := func() { pls.Stop() }
 
 	if pls.rootchainManager, err = NewRootChainManager(
@@ -217,6 +246,7 @@ func New(ctx *node.ServiceContext, config *Config) (*Plasma, error) {
 		pls.accountManager,
 		pls.miner,
 		epochEnv,
+		pls.rootchainEvents,
 	); err != nil {
 		return nil, err
 	}
@@ -259,6 +289,11 @@ func CreateConsensusEngine(ctx *node.ServiceContext, chainConfig *params.ChainCo
 	if chainConfig.Clique != nil {
 		return clique.New(chainConfig.Clique, db)
 	}
+	// If operator signatures are requested, set it up. The operator is set once
+	// the RootChain contract is connected.
+	if chainConfig.CliquePlasma != nil {
+		return cliqueplasma.New(chainConfig.CliquePlasma)
+	}
 	// Otherwise assume proof-of-work
 	switch config.PowMode {
 	case ethash.ModeFake:
@@ -292,30 +327,35 @@ func (s *Plasma) APIs() []rpc.API {
 	// Append any APIs exposed explicitly by the consensus engine
 	apis = append(apis, s.engine.APIs(s.BlockChain())...)
 
-	// Append all the local APIs and return
-	return append(apis, []rpc.API{
+	// Append all the local APIs
+	apis = append(apis, []rpc.API{
 		{
-			Namespace: "eth", // TODO: use "pls" namespace
+			Namespace: "eth",
 			Version:   "1.0",
 			Service:   NewPublicPlasmaAPI(s),
 			Public:    true,
 		}, {
-			Namespace: "eth", // TODO: use "pls" namespace
+			Namespace: "eth",
 			Version:   "1.0",
 			Service:   NewPublicMinerAPI(s),
 			Public:    true,
 		}, {
-			Namespace: "eth", // TODO: use "pls" namespace
+			Namespace: "eth",
 			Version:   "1.0",
 			Service:   downloader.NewPublicDownloaderAPI(s.protocolManager.downloader, s.eventMux),
 			Public:    true,
+		}, {
+			Namespace: "pls",
+			Version:   "1.0",
+			Service:   NewPublicRootChainAPI(s),
+			Public:    true,
 		}, {
 			Namespace: "miner",
 			Version:   "1.0",
 			Service:   NewPrivateMinerAPI(s),
 			Public:    false,
 		}, {
-			Namespace: "eth", // TODO: use "pls" namespace
+			Namespace: "eth",
 			Version:   "1.0",
 			Service:   filters.NewPublicFilterAPI(s.APIBackend, false),
 			Public:    true,
@@ -339,6 +379,16 @@ func (s *Plasma) APIs() []rpc.API {
 			Public:    true,
 		},
 	}...)
+
+	// Serve the chain services under the "pls" namespace too, keeping "eth" for
+	// the existing web3 tooling
+	for _, api := range apis {
+		if api.Namespace == "eth" {
+			api.Namespace = "pls"
+			apis = append(apis, api)
+		}
+	}
+	return apis
 }
 
 func (s *Plasma) ResetWithGenesisBlock(gb *types.Block) {
@@ -468,6 +518,18 @@ func (s *Plasma) StartMining(threads int) error {
 			}
 			clique.Authorize(eb, wallet.SignHash)
 		}
+		if engine, ok := s.engine.(*cliqueplasma.Clique); ok {
+			if operator := engine.Operator(); eb != operator {
+				log.Error("Etherbase is not the operator", "etherbase", eb, "operator", operator)
+				return fmt.Errorf("etherbase %x is not the operator %x", eb, operator)
+			}
+			wallet, err := s.accountManager.Find(accounts.Account{Address: eb})
+			if wallet == nil || err != nil {
+				log.Error("Operator account unavailable locally", "err", err)
+				return fmt.Errorf("signer missing: %v", err)
+			}
+			engine.Authorize(eb, wallet.SignHash)
+		}
 		// If mining is started, we can disable the transaction rejection mechanism
 		// introduced to speed sync times.
 		atomic.StoreUint32(&s.protocolManager.acceptTxs, 1)
@@ -505,6 +567,9 @@ func (s *Plasma) EthVersion() int                    { return int(s.protocolMana
 func (s *Plasma) NetVersion() uint64                 { return s.networkID }
 func (s *Plasma) Downloader() *downloader.Downloader { return s.protocolManager.downloader }
 
+// RootChainManager returns the manager of the operator's RootChain contract.
+func (s *Plasma) RootChainManager() *RootChainManager { return s.rootchainManager }
+
 // Protocols implements node.Service, returning all the currently configured
 // network protocols to start.
 func (s *Plasma) Protocols() []p2p.Protocol {
@@ -537,6 +602,7 @@ func (s *Plasma) Start(srvr *p2p.Server) error {
 		s.lesServer.Start(srvr)
 	}
 
+	s.rootchainEvents.Start()
 	if err := s.rootchainManager.Start(); err != nil {
 		return err
 	}
@@ -559,6 +625,7 @@ func (s *Plasma) Stop() error {
 	s.txPool.Stop()
 	s.miner.Stop()
 	s.eventMux.Stop()
+	s.rootchainEvents.Stop()
 
 	s.chainDb.Close()
 	s.rootchainManager.Stop()
@@ -0,0 +1,77 @@
+package pls
+
+import (
+	"testing"
+
+	"github.com/Onther-Tech/plasma-evm/accounts"
+	"github.com/Onther-Tech/plasma-evm/consensus/cliqueplasma"
+	"github.com/Onther-Tech/plasma-evm/consensus/ethash"
+	"github.com/Onther-Tech/plasma-evm/core"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/core/vm"
+	"github.com/Onther-Tech/plasma-evm/crypto"
+	"github.com/Onther-Tech/plasma-evm/ethdb"
+	"github.com/Onther-Tech/plasma-evm/params"
+)
+
+// Tests that a node initialized with an operator-signed genesis runs the
+// CliquePlasma engine, and that it seals the empty blocks completing an epoch.
+func TestCliquePlasmaNode(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	operator := crypto.PubkeyToAddress(key.PublicKey)
+
+	// Initialize the database like `geth init` does
+	config := *params.PlasmaChainConfig
+	config.Ethash = nil
+	config.CliquePlasma = &params.CliqueConfig{Period: 0}
+
+	genesis := core.DefaultGenesisBlock()
+	genesis.Config = &config
+
+	db := ethdb.NewMemDatabase()
+	genesis.MustCommit(db)
+
+	// The node is configured without a genesis, the stored one picks the engine
+	chainConfig, _, err := core.SetupGenesisBlockWithOverride(db, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to set up genesis: %v", err)
+	}
+	engine, ok := CreateConsensusEngine(nil, chainConfig, &ethash.Config{}, nil, false, db).(*cliqueplasma.Clique)
+	if !ok {
+		t.Fatalf("engine mismatch: have %T, want %T", engine, &cliqueplasma.Clique{})
+	}
+	engine.SetOperator(operator)
+	engine.Authorize(operator, func(account accounts.Account, hash []byte) ([]byte, error) {
+		return crypto.Sign(hash, key)
+	})
+
+	chain, err := core.NewBlockChain(db, nil, chainConfig, engine, vm.Config{}, nil)
+	if err != nil {
+		t.Fatalf("failed to create blockchain: %v", err)
+	}
+	defer chain.Stop()
+
+	blocks, _ := core.GenerateChain(chainConfig, chain.Genesis(), engine, db, 1, func(i int, b *core.BlockGen) {
+		b.SetExtra(make([]byte, 32+65))
+	})
+	results := make(chan *types.Block, 1)
+
+	// An empty block is only sealed if it completes the epoch
+	if err := engine.Seal(chain, blocks[0], results, nil); err == nil {
+		t.Fatalf("empty block sealed outside of an epoch end")
+	}
+	engine.SetEpochEnd(func(*types.Header) bool { return true })
+	if err := engine.Seal(chain, blocks[0], results, nil); err != nil {
+		t.Fatalf("failed to seal empty block completing the epoch: %v", err)
+	}
+	sealed := <-results
+	if author, err := engine.Author(sealed.Header()); err != nil || author != operator {
+		t.Fatalf("author mismatch: have %x (%v), want %x", author, err, operator)
+	}
+	if _, err := chain.InsertChain(types.Blocks{sealed}); err != nil {
+		t.Fatalf("failed to insert sealed block: %v", err)
+	}
+	if head := chain.CurrentBlock().NumberU64(); head != 1 {
+		t.Errorf("head mismatch: have %d, want 1", head)
+	}
+}
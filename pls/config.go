@@ -55,10 +55,13 @@ var DefaultConfig = Config{
 	MinerGasPrice:  big.NewInt(params.GWei),
 	MinerRecommit:  3 * time.Second,
 
+	WithholdingTimeout: 5 * time.Minute,
+
 	TxPool: core.DefaultTxPoolConfig,
 	GPO: gasprice.Config{
-		Blocks:     20,
-		Percentile: 60,
+		Blocks:         20,
+		Percentile:     60,
+		FastPercentile: 90,
 	},
 }
 
@@ -87,6 +90,11 @@ type Config struct {
 	Operator          accounts.Account
 	RootChainURL      string
 	RootChainContract common.Address
+	RootChainSync     bool // Fast sync from the last finalized block, or light sync headers submitted to the RootChain contract
+
+	// Block withholding detection options
+	WithholdingTimeout     time.Duration // Time to wait for a submitted block before it is considered withheld (0 = disabled)
+	WithholdingPrepareExit bool          // Prepare exit requests and ERUs for local accounts when a withheld block is detected
 
 	// Protocol options
 	NetworkId uint64 // Network ID to use for selecting peers to connect to
@@ -31,6 +31,7 @@ import (
 	"github.com/Onther-Tech/plasma-evm/core/types"
 	"github.com/Onther-Tech/plasma-evm/ethdb"
 	"github.com/Onther-Tech/plasma-evm/event"
+	"github.com/Onther-Tech/plasma-evm/light"
 	"github.com/Onther-Tech/plasma-evm/log"
 	"github.com/Onther-Tech/plasma-evm/metrics"
 	"github.com/Onther-Tech/plasma-evm/params"
@@ -93,6 +94,10 @@ var (
 	errCancelContentProcessing = errors.New("content processing canceled (requested)")
 	errNoSyncActive            = errors.New("no sync active")
 	errTooOld                  = errors.New("peer doesn't speak recent enough protocol version (need version >= 62)")
+	errPivotUnavailable        = errors.New("peer head is below the pivot block")
+	errInvalidPivot            = errors.New("retrieved pivot block state root mismatch")
+	errNoTrustedPivot          = errors.New("no trusted pivot block")
+	errHeadersNotAnchored      = errors.New("peer headers ahead of the anchor")
 )
 
 type Downloader struct {
@@ -116,6 +121,8 @@ type Downloader struct {
 	lightchain LightChain
 	blockchain BlockChain
 
+	pivotOracle PivotOracle // Trusted source of the fast sync pivot (nil = pick from the peer head)
+
 	// Callbacks
 	dropPeer peerDropFn // Drops a peer for misbehaving
 
@@ -204,6 +211,14 @@ type BlockChain interface {
 	InsertReceiptChain(types.Blocks, []types.Receipts) (int, error)
 }
 
+// PivotOracle provides the pivot block of fast sync from a trusted source instead
+// of the head of the syncing peer.
+type PivotOracle interface {
+	// Pivot retrieves the number and the state root of the pivot block. It fails
+	// if there is no trusted pivot yet, a zero number is rejected likewise.
+	Pivot() (uint64, common.Hash, error)
+}
+
 // New creates a new downloader to fetch hashes and blocks from remote peers.
 func New(mode SyncMode, stateDb ethdb.Database, mux *event.TypeMux, chain BlockChain, lightchain LightChain, dropPeer peerDropFn) *Downloader {
 	if lightchain == nil {
@@ -240,6 +255,12 @@ func New(mode SyncMode, stateDb ethdb.Database, mux *event.TypeMux, chain BlockC
 	return dl
 }
 
+// SetPivotOracle sets the trusted source of the fast sync pivot. The state root
+// of the pivot block downloaded from peers must match the one of the oracle.
+func (d *Downloader) SetPivotOracle(oracle PivotOracle) {
+	d.pivotOracle = oracle
+}
+
 // Progress retrieves the synchronisation boundaries, specifically the origin
 // block where synchronisation started at (may have failed/suspended); the block
 // or header sync is currently at; and the latest known block which the sync targets.
@@ -328,7 +349,7 @@ func (d *Downloader) Synchronise(id string, head common.Hash, td *big.Int, mode
 
 	case errTimeout, errBadPeer, errStallingPeer,
 		errEmptyHeaderSet, errPeersUnavailable, errTooOld,
-		errInvalidAncestor, errInvalidChain:
+		errInvalidAncestor, errInvalidChain, errInvalidPivot:
 		log.Warn("Synchronisation failed, dropping peer", "peer", id, "err", err)
 		if d.dropPeer == nil {
 			// The dropPeer method is nil when `--copydb` is used for a local copy.
@@ -337,6 +358,9 @@ func (d *Downloader) Synchronise(id string, head common.Hash, td *big.Int, mode
 		} else {
 			d.dropPeer(id)
 		}
+	case errHeadersNotAnchored:
+		log.Debug("Synchronisation paused until the headers are anchored", "peer", id)
+
 	default:
 		log.Warn("Synchronisation failed, retrying", "err", err)
 	}
@@ -446,15 +470,29 @@ func (d *Downloader) syncWithPeer(p *peerConnection, hash common.Hash, td *big.I
 	d.syncStatsLock.Unlock()
 
 	// Ensure our origin point is below any fast sync pivot point
-	pivot := uint64(0)
+	var (
+		pivot     uint64
+		pivotRoot common.Hash // State root of a trusted pivot, empty if picked from the peer head
+	)
 	if d.mode == FastSync {
-		if height <= uint64(fsMinFullBlocks) {
-			origin = 0
-		} else {
-			pivot = height - uint64(fsMinFullBlocks)
-			if pivot <= origin {
-				origin = pivot - 1
+		if d.pivotOracle != nil {
+			if pivot, pivotRoot, err = d.pivotOracle.Pivot(); err != nil {
+				return err
+			}
+			if pivot == 0 {
+				return errNoTrustedPivot
+			}
+			if pivot > height {
+				return errPivotUnavailable
 			}
+			log.Debug("Fast sync pivot retrieved from oracle", "number", pivot, "root", pivotRoot)
+		} else if height > uint64(fsMinFullBlocks) {
+			pivot = height - uint64(fsMinFullBlocks)
+		}
+		if pivot == 0 {
+			origin = 0
+		} else if pivot <= origin {
+			origin = pivot - 1
 		}
 	}
 	d.committed = 1
@@ -474,7 +512,7 @@ func (d *Downloader) syncWithPeer(p *peerConnection, hash common.Hash, td *big.I
 		func() error { return d.processHeaders(origin+1, pivot, td) },
 	}
 	if d.mode == FastSync {
-		fetchers = append(fetchers, func() error { return d.processFastSyncContent(latest) })
+		fetchers = append(fetchers, func() error { return d.processFastSyncContent(latest, pivot, pivotRoot) })
 	} else if d.mode == FullSync {
 		fetchers = append(fetchers, d.processFullSyncContent)
 	}
@@ -1399,6 +1437,13 @@ func (d *Downloader) processHeaders(origin uint64, pivot uint64, td *big.Int) er
 						frequency = 1
 					}
 					if n, err := d.lightchain.InsertHeaderChain(chunk, frequency); err != nil {
+						// If the peer is simply ahead of the anchor, keep the anchored
+						// headers and retry the rest later without blaming the peer
+						if err == light.ErrHeaderNotAnchored {
+							log.Debug("Header not anchored yet", "number", chunk[n].Number, "hash", chunk[n].Hash())
+							rollback = nil
+							return errHeadersNotAnchored
+						}
 						// If some headers were inserted, add them too to the rollback list
 						if n > 0 {
 							rollback = append(rollback, chunk[:n]...)
@@ -1496,22 +1541,24 @@ func (d *Downloader) importBlockResults(results []*fetchResult) error {
 
 // processFastSyncContent takes fetch results from the queue and writes them to the
 // database. It also controls the synchronisation of state nodes of the pivot block.
-func (d *Downloader) processFastSyncContent(latest *types.Header) error {
-	// Start syncing state of the reported head block. This should get us most of
-	// the state of the pivot block.
-	stateSync := d.syncState(latest.Root)
+//
+// If the pivot root is given, the pivot is trusted: it never moves, and the pivot
+// block must have the given state root. Otherwise the pivot may move if the sync
+// takes long enough for the chain head to move significantly.
+func (d *Downloader) processFastSyncContent(latest *types.Header, pivot uint64, pivotRoot common.Hash) error {
+	// Start syncing state of the trusted pivot, or of the reported head block. The
+	// latter should get us most of the state of the pivot block.
+	root := latest.Root
+	if pivotRoot != (common.Hash{}) {
+		root = pivotRoot
+	}
+	stateSync := d.syncState(root)
 	defer stateSync.Cancel()
 	go func() {
 		if err := stateSync.Wait(); err != nil && err != errCancelStateFetch {
 			d.queue.Close() // wake up Results
 		}
 	}()
-	// Figure out the ideal pivot block. Note, that this goalpost may move if the
-	// sync takes long enough for the chain head to move significantly.
-	pivot := uint64(0)
-	if height := latest.Number.Uint64(); height > uint64(fsMinFullBlocks) {
-		pivot = height - uint64(fsMinFullBlocks)
-	}
 	// To cater for moving pivot points, track the pivot block and subsequently
 	// accumulated download results separately.
 	var (
@@ -1541,7 +1588,7 @@ func (d *Downloader) processFastSyncContent(latest *types.Header) error {
 			results = append(append([]*fetchResult{oldPivot}, oldTail...), results...)
 		}
 		// Split around the pivot block and process the two sides via fast/full sync
-		if atomic.LoadInt32(&d.committed) == 0 {
+		if atomic.LoadInt32(&d.committed) == 0 && pivotRoot == (common.Hash{}) {
 			latest = results[len(results)-1].Header
 			if height := latest.Number.Uint64(); height > pivot+2*uint64(fsMinFullBlocks) {
 				log.Warn("Pivot became stale, moving", "old", pivot, "new", height-uint64(fsMinFullBlocks))
@@ -1553,6 +1600,11 @@ func (d *Downloader) processFastSyncContent(latest *types.Header) error {
 			return err
 		}
 		if P != nil {
+			// Never trust a pivot block other than the one of the oracle
+			if pivotRoot != (common.Hash{}) && P.Header.Root != pivotRoot {
+				log.Warn("Pivot block state root mismatch", "number", pivot, "have", P.Header.Root, "want", pivotRoot)
+				return errInvalidPivot
+			}
 			// If new pivot block found, cancel old state retrieval and restart
 			if oldPivot != P {
 				stateSync.Cancel()
@@ -31,6 +31,7 @@ import (
 	"github.com/Onther-Tech/plasma-evm/core/types"
 	"github.com/Onther-Tech/plasma-evm/ethdb"
 	"github.com/Onther-Tech/plasma-evm/event"
+	"github.com/Onther-Tech/plasma-evm/light"
 	"github.com/Onther-Tech/plasma-evm/trie"
 )
 
@@ -56,6 +57,8 @@ type downloadTester struct {
 	ownReceipts map[common.Hash]types.Receipts // Receipts belonging to the tester
 	ownChainTd  map[common.Hash]*big.Int       // Total difficulties of the blocks in the local chain
 
+	anchored uint64 // Last header number accepted by a simulated anchor (0 = no anchor)
+
 	lock sync.RWMutex
 }
 
@@ -215,6 +218,9 @@ func (dl *downloadTester) InsertHeaderChain(headers []*types.Header, checkFreq i
 	}
 	// Do a full insert if pre-checks passed
 	for i, header := range headers {
+		if dl.anchored != 0 && header.Number.Uint64() > dl.anchored {
+			return i, light.ErrHeaderNotAnchored
+		}
 		if _, ok := dl.ownHeaders[header.Hash()]; ok {
 			continue
 		}
@@ -1567,3 +1573,69 @@ func TestRemoteHeaderRequestSpan(t *testing.T) {
 		}
 	}
 }
+
+// testPivotOracle is a PivotOracle returning a fixed pivot.
+type testPivotOracle struct {
+	number uint64
+	root   common.Hash
+}
+
+func (o *testPivotOracle) Pivot() (uint64, common.Hash, error) { return o.number, o.root, nil }
+
+// Tests that fast sync fails instead of picking the pivot from the peer if the
+// pivot oracle has no trusted pivot yet.
+func TestPivotOracle(t *testing.T) {
+	t.Parallel()
+
+	tester := newTester()
+	defer tester.terminate()
+
+	chain := testChainBase.shorten(blockCacheItems - 15)
+	tester.newPeer("peer", 63, chain)
+
+	tester.downloader.SetPivotOracle(&testPivotOracle{})
+	if err := tester.sync("peer", nil, FastSync); err != errNoTrustedPivot {
+		t.Fatalf("sync error mismatch: have %v, want %v", err, errNoTrustedPivot)
+	}
+	assertOwnChain(t, tester, 1)
+}
+
+// Tests that the headers of a peer ahead of the anchor are inserted up to the
+// last anchored one, without dropping the peer, and that syncing resumes once
+// the rest is anchored.
+func TestHeadersNotAnchored(t *testing.T) {
+	t.Parallel()
+
+	tester := newTester()
+	defer tester.terminate()
+
+	chain := testChainBase.shorten(blockCacheItems - 15)
+	tester.newPeer("peer", 64, chain)
+
+	anchored := chain.len() / 2
+	tester.lock.Lock()
+	tester.anchored = uint64(anchored)
+	tester.lock.Unlock()
+
+	if err := tester.downloader.Synchronise("peer", chain.headBlock().Hash(), chain.td(chain.headBlock().Hash()), LightSync); err != errHeadersNotAnchored {
+		t.Fatalf("sync error mismatch: have %v, want %v", err, errHeadersNotAnchored)
+	}
+	tester.lock.RLock()
+	_, ok := tester.peers["peer"]
+	tester.lock.RUnlock()
+	if !ok {
+		t.Fatal("peer ahead of the anchor dropped")
+	}
+	if head := tester.CurrentHeader().Number.Uint64(); head != uint64(anchored) {
+		t.Fatalf("anchored head mismatch: have %d, want %d", head, anchored)
+	}
+
+	tester.lock.Lock()
+	tester.anchored = 0
+	tester.lock.Unlock()
+
+	if err := tester.sync("peer", nil, LightSync); err != nil {
+		t.Fatalf("failed to synchronise blocks: %v", err)
+	}
+	assertOwnChain(t, tester, chain.len())
+}
@@ -0,0 +1,18 @@
+package pls
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+)
+
+// BlockWithheldEvent is posted when a block submitted to the RootChain contract
+// is not available locally after the withholding timeout.
+type BlockWithheldEvent struct {
+	ForkNumber  *big.Int
+	EpochNumber *big.Int
+	BlockNumber *big.Int
+	StatesRoot  common.Hash
+	SubmittedAt time.Time
+}
@@ -32,9 +32,23 @@ import (
 var maxPrice = big.NewInt(500 * params.GWei)
 
 type Config struct {
-	Blocks     int
-	Percentile int
-	Default    *big.Int `toml:",omitempty"`
+	Blocks         int
+	Percentile     int
+	FastPercentile int
+	Default        *big.Int `toml:",omitempty"`
+}
+
+// Suggestion is the pair of gas prices recommended on a plasma chain. Request
+// blocks don't include user transactions, so both assume the transaction waits
+// for a non-request block.
+//
+// Both prices are percentiles of the same set, the lowest user transaction gas
+// price of each recent non-request block. The fast price is taken at a higher
+// percentile, so it would have been accepted by more of the recent blocks. It
+// doesn't take the pending transactions into account.
+type Suggestion struct {
+	NextNRB *big.Int // Price to be included in the next non-request block
+	Fast    *big.Int // Price accepted by more of the recent non-request blocks
 }
 
 // Oracle recommends gas prices based on the content of recent
@@ -43,11 +57,12 @@ type Oracle struct {
 	backend   ethapi.Backend
 	lastHead  common.Hash
 	lastPrice *big.Int
+	lastFast  *big.Int
 	cacheLock sync.RWMutex
 	fetchLock sync.Mutex
 
 	checkBlocks, maxEmpty, maxBlocks int
-	percentile                       int
+	percentile, fastPercentile       int
 }
 
 // NewOracle returns a new oracle.
@@ -56,34 +71,53 @@ func NewOracle(backend ethapi.Backend, params Config) *Oracle {
 	if blocks < 1 {
 		blocks = 1
 	}
-	percent := params.Percentile
+	percent := clampPercentile(params.Percentile)
+	fastPercent := clampPercentile(params.FastPercentile)
+	if fastPercent < percent {
+		fastPercent = percent
+	}
+	return &Oracle{
+		backend:        backend,
+		lastPrice:      params.Default,
+		lastFast:       params.Default,
+		checkBlocks:    blocks,
+		maxEmpty:       blocks / 2,
+		maxBlocks:      blocks * 5,
+		percentile:     percent,
+		fastPercentile: fastPercent,
+	}
+}
+
+func clampPercentile(percent int) int {
 	if percent < 0 {
-		percent = 0
+		return 0
 	}
 	if percent > 100 {
-		percent = 100
-	}
-	return &Oracle{
-		backend:     backend,
-		lastPrice:   params.Default,
-		checkBlocks: blocks,
-		maxEmpty:    blocks / 2,
-		maxBlocks:   blocks * 5,
-		percentile:  percent,
+		return 100
 	}
+	return percent
 }
 
-// SuggestPrice returns the recommended gas price.
+// SuggestPrice returns the recommended gas price to be included in the next
+// non-request block.
 func (gpo *Oracle) SuggestPrice(ctx context.Context) (*big.Int, error) {
+	suggestion, err := gpo.SuggestPrices(ctx)
+	return suggestion.NextNRB, err
+}
+
+// SuggestPrices returns the recommended gas prices for the next non-request
+// block and for fast inclusion. Request blocks and request transactions are
+// ignored, since their gas price says nothing about user demand.
+func (gpo *Oracle) SuggestPrices(ctx context.Context) (*Suggestion, error) {
 	gpo.cacheLock.RLock()
 	lastHead := gpo.lastHead
-	lastPrice := gpo.lastPrice
+	last := &Suggestion{NextNRB: gpo.lastPrice, Fast: gpo.lastFast}
 	gpo.cacheLock.RUnlock()
 
 	head, _ := gpo.backend.HeaderByNumber(ctx, rpc.LatestBlockNumber)
 	headHash := head.Hash()
 	if headHash == lastHead {
-		return lastPrice, nil
+		return last, nil
 	}
 
 	gpo.fetchLock.Lock()
@@ -92,10 +126,10 @@ func (gpo *Oracle) SuggestPrice(ctx context.Context) (*big.Int, error) {
 	// try checking the cache again, maybe the last fetch fetched what we need
 	gpo.cacheLock.RLock()
 	lastHead = gpo.lastHead
-	lastPrice = gpo.lastPrice
+	last = &Suggestion{NextNRB: gpo.lastPrice, Fast: gpo.lastFast}
 	gpo.cacheLock.RUnlock()
 	if headHash == lastHead {
-		return lastPrice, nil
+		return last, nil
 	}
 
 	blockNum := head.Number.Uint64()
@@ -113,14 +147,15 @@ func (gpo *Oracle) SuggestPrice(ctx context.Context) (*big.Int, error) {
 	for exp > 0 {
 		res := <-ch
 		if res.err != nil {
-			return lastPrice, res.err
+			return last, res.err
 		}
 		exp--
 		if res.price != nil {
 			blockPrices = append(blockPrices, res.price)
 			continue
 		}
-		if maxEmpty > 0 {
+		// Request blocks are replaced by older blocks without counting as empty
+		if !res.request && maxEmpty > 0 {
 			maxEmpty--
 			continue
 		}
@@ -131,25 +166,31 @@ func (gpo *Oracle) SuggestPrice(ctx context.Context) (*big.Int, error) {
 			blockNum--
 		}
 	}
-	price := lastPrice
+	suggestion := &Suggestion{NextNRB: last.NextNRB, Fast: last.Fast}
 	if len(blockPrices) > 0 {
 		sort.Sort(bigIntArray(blockPrices))
-		price = blockPrices[(len(blockPrices)-1)*gpo.percentile/100]
+		suggestion.NextNRB = blockPrices[(len(blockPrices)-1)*gpo.percentile/100]
+		suggestion.Fast = blockPrices[(len(blockPrices)-1)*gpo.fastPercentile/100]
 	}
-	if price.Cmp(maxPrice) > 0 {
-		price = new(big.Int).Set(maxPrice)
+	if suggestion.NextNRB.Cmp(maxPrice) > 0 {
+		suggestion.NextNRB = new(big.Int).Set(maxPrice)
+	}
+	if suggestion.Fast.Cmp(maxPrice) > 0 {
+		suggestion.Fast = new(big.Int).Set(maxPrice)
 	}
 
 	gpo.cacheLock.Lock()
 	gpo.lastHead = headHash
-	gpo.lastPrice = price
+	gpo.lastPrice = suggestion.NextNRB
+	gpo.lastFast = suggestion.Fast
 	gpo.cacheLock.Unlock()
-	return price, nil
+	return suggestion, nil
 }
 
 type getBlockPricesResult struct {
-	price *big.Int
-	err   error
+	price   *big.Int
+	request bool // Whether the block is a request block
+	err     error
 }
 
 type transactionsByGasPrice []*types.Transaction
@@ -159,11 +200,16 @@ func (t transactionsByGasPrice) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
 func (t transactionsByGasPrice) Less(i, j int) bool { return t[i].GasPrice().Cmp(t[j].GasPrice()) < 0 }
 
 // getBlockPrices calculates the lowest transaction gas price in a given block
-// and sends it to the result channel. If the block is empty, price is nil.
+// and sends it to the result channel. If the block is empty or a request block,
+// price is nil.
 func (gpo *Oracle) getBlockPrices(ctx context.Context, signer types.Signer, blockNum uint64, ch chan getBlockPricesResult) {
 	block, err := gpo.backend.BlockByNumber(ctx, rpc.BlockNumber(blockNum))
 	if block == nil {
-		ch <- getBlockPricesResult{nil, err}
+		ch <- getBlockPricesResult{nil, false, err}
+		return
+	}
+	if block.IsRequest() {
+		ch <- getBlockPricesResult{nil, true, nil}
 		return
 	}
 
@@ -174,12 +220,12 @@ func (gpo *Oracle) getBlockPrices(ctx context.Context, signer types.Signer, bloc
 
 	for _, tx := range txs {
 		sender, err := types.Sender(signer, tx)
-		if err == nil && sender != block.Coinbase() {
-			ch <- getBlockPricesResult{tx.GasPrice(), nil}
+		if err == nil && sender != block.Coinbase() && sender != params.NullAddress {
+			ch <- getBlockPricesResult{tx.GasPrice(), false, nil}
 			return
 		}
 	}
-	ch <- getBlockPricesResult{nil, nil}
+	ch <- getBlockPricesResult{nil, false, nil}
 }
 
 type bigIntArray []*big.Int
@@ -0,0 +1,160 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package gasprice
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/crypto"
+	"github.com/Onther-Tech/plasma-evm/internal/ethapi"
+	"github.com/Onther-Tech/plasma-evm/params"
+	"github.com/Onther-Tech/plasma-evm/rpc"
+)
+
+// testBackend serves the blocks of a generated plasma chain to the oracle.
+type testBackend struct {
+	ethapi.Backend
+	blocks []*types.Block
+}
+
+func (b *testBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error) {
+	block, err := b.BlockByNumber(ctx, number)
+	if block == nil {
+		return nil, err
+	}
+	return block.Header(), nil
+}
+
+func (b *testBackend) BlockByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Block, error) {
+	if number == rpc.LatestBlockNumber {
+		return b.blocks[len(b.blocks)-1], nil
+	}
+	if int(number) < len(b.blocks) {
+		return b.blocks[number], nil
+	}
+	return nil, nil
+}
+
+func (b *testBackend) ChainConfig() *params.ChainConfig {
+	return params.PlasmaChainConfig
+}
+
+// newTestBackend generates a plasma chain of n blocks on top of the genesis.
+// The blocks listed in orbs are request blocks, the others have a transaction
+// with a gas price of the block number in gwei and a cheaper one sent by the
+// miner.
+func newTestBackend(t *testing.T, n int, orbs ...int) *testBackend {
+	key, _ := crypto.GenerateKey()
+	miner, _ := crypto.GenerateKey()
+	signer := types.NewEIP155Signer(params.PlasmaChainConfig.ChainID)
+
+	isORB := make(map[int]bool)
+	for _, number := range orbs {
+		isORB[number] = true
+	}
+	blocks := []*types.Block{types.NewBlockWithHeader(&types.Header{Number: big.NewInt(0)})}
+	for i := 1; i <= n; i++ {
+		var txs types.Transactions
+		if isORB[i] {
+			// Request transactions are not signed, i.e. sent by the null address
+			txs = types.Transactions{
+				types.NewTransaction(0, common.Address{}, big.NewInt(0), params.RequestTxGasLimit, params.RequestTxGasPrice, nil),
+			}
+		} else {
+			tx, err := types.SignTx(types.NewTransaction(uint64(i), common.Address{}, big.NewInt(0), params.TxGas, new(big.Int).Mul(big.NewInt(int64(i)), big.NewInt(params.GWei)), nil), signer, key)
+			if err != nil {
+				t.Fatalf("failed to sign transaction: %v", err)
+			}
+			own, err := types.SignTx(types.NewTransaction(uint64(i), common.Address{}, big.NewInt(0), params.TxGas, big.NewInt(1), nil), signer, miner)
+			if err != nil {
+				t.Fatalf("failed to sign transaction: %v", err)
+			}
+			txs = types.Transactions{tx, own}
+		}
+		blocks = append(blocks, types.NewBlock(&types.Header{
+			ParentHash: blocks[i-1].Hash(),
+			Number:     big.NewInt(int64(i)),
+			Coinbase:   crypto.PubkeyToAddress(miner.PublicKey),
+		}, txs, nil, nil))
+	}
+	return &testBackend{blocks: blocks}
+}
+
+func gwei(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(params.GWei))
+}
+
+// Tests that request blocks are replaced by older non-request blocks, and that
+// the suggestions are the percentiles of the lowest user prices of those blocks.
+func TestSuggestPrices(t *testing.T) {
+	tests := []struct {
+		blocks int
+		orbs   []int
+		next   *big.Int
+		fast   *big.Int
+	}{
+		// Blocks 10, 9, 8, 7, 6: sorted prices 6, 7, 8, 9, 10
+		{10, nil, gwei(8), gwei(9)},
+		// ORBs 9 and 6 are replaced by 5 and 4: sorted prices 4, 5, 7, 8, 10
+		{10, []int{3, 6, 9}, gwei(7), gwei(8)},
+		// ORBs only, the default price is kept
+		{3, []int{1, 2, 3}, gwei(2), gwei(2)},
+	}
+	for i, tt := range tests {
+		gpo := NewOracle(newTestBackend(t, tt.blocks, tt.orbs...), Config{
+			Blocks:         5,
+			Percentile:     50,
+			FastPercentile: 90,
+			Default:        gwei(2),
+		})
+		suggestion, err := gpo.SuggestPrices(context.Background())
+		if err != nil {
+			t.Fatalf("test %d: failed to suggest prices: %v", i, err)
+		}
+		if suggestion.NextNRB.Cmp(tt.next) != 0 {
+			t.Errorf("test %d: next NRB price mismatch: have %v, want %v", i, suggestion.NextNRB, tt.next)
+		}
+		if suggestion.Fast.Cmp(tt.fast) != 0 {
+			t.Errorf("test %d: fast price mismatch: have %v, want %v", i, suggestion.Fast, tt.fast)
+		}
+		price, err := gpo.SuggestPrice(context.Background())
+		if err != nil || price.Cmp(tt.next) != 0 {
+			t.Errorf("test %d: suggested price mismatch: have %v (%v), want %v", i, price, err, tt.next)
+		}
+	}
+}
+
+// Tests that the fast percentile is never below the next NRB percentile.
+func TestSuggestPricesFastPercentile(t *testing.T) {
+	gpo := NewOracle(newTestBackend(t, 10), Config{
+		Blocks:         5,
+		Percentile:     90,
+		FastPercentile: 10,
+		Default:        gwei(1),
+	})
+	suggestion, err := gpo.SuggestPrices(context.Background())
+	if err != nil {
+		t.Fatalf("failed to suggest prices: %v", err)
+	}
+	if suggestion.Fast.Cmp(suggestion.NextNRB) != 0 {
+		t.Errorf("fast price mismatch: have %v, want %v", suggestion.Fast, suggestion.NextNRB)
+	}
+}
@@ -54,6 +54,16 @@ var (
 	miscInTrafficMeter        = metrics.NewRegisteredMeter("eth/misc/in/traffic", nil)
 	miscOutPacketsMeter       = metrics.NewRegisteredMeter("eth/misc/out/packets", nil)
 	miscOutTrafficMeter       = metrics.NewRegisteredMeter("eth/misc/out/traffic", nil)
+
+	withheldBlockMeter   = metrics.NewRegisteredMeter("pls/rootchain/blocks/withheld", nil)
+	withheldBlockCounter = metrics.NewRegisteredCounter("pls/rootchain/blocks/withheld/current", nil)
+
+	epochHandledMeter   = metrics.NewRegisteredMeter("pls/rootchain/epochs/handled", nil)
+	submittedBlockMeter = metrics.NewRegisteredMeter("pls/rootchain/blocks/submitted", nil)
+	revertedBlockMeter  = metrics.NewRegisteredMeter("pls/rootchain/blocks/reverted", nil)
+	requestFetchTimer   = metrics.NewRegisteredTimer("pls/rootchain/requests/fetch", nil)
+	challengeSentMeter  = metrics.NewRegisteredMeter("pls/rootchain/challenges/sent", nil)
+	rootchainErrorMeter = metrics.NewRegisteredMeter("pls/rootchain/rpc/errors", nil)
 )
 
 // meteredMsgReadWriter is a wrapper around a p2p.MsgReadWriter, capable of
@@ -0,0 +1,34 @@
+package pls
+
+import (
+	"github.com/Onther-Tech/plasma-evm/accounts/abi"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/ethdb"
+	"github.com/Onther-Tech/plasma-evm/internal/rootchaintest"
+)
+
+// testRootChainBackend is an in-process rootchain node serving the subset of
+// the eth namespace used by the RootChainManager.
+type testRootChainBackend struct {
+	*rootchaintest.Backend
+}
+
+func newTestRootChainBackend(contractABI abi.ABI) *testRootChainBackend {
+	return &testRootChainBackend{rootchaintest.NewBackend(contractABI)}
+}
+
+// manager returns a RootChainManager talking to the backend.
+func (b *testRootChainBackend) manager(config *Config) *RootChainManager {
+	backend := b.Client()
+	contract, err := rootchain.NewRootChain(config.RootChainContract, backend)
+	if err != nil {
+		panic(err)
+	}
+	return &RootChainManager{
+		config:            config,
+		backend:           backend,
+		rootchainContract: contract,
+		events:            newRootchainEventIndex(ethdb.NewMemDatabase(), backend, config.RootChainContract),
+		quit:              make(chan struct{}),
+	}
+}
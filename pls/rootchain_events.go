@@ -0,0 +1,616 @@
+package pls
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	ethereum "github.com/Onther-Tech/plasma-evm"
+	"github.com/Onther-Tech/plasma-evm/accounts/abi/bind"
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/common/hexutil"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/core/rawdb"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/ethclient"
+	"github.com/Onther-Tech/plasma-evm/ethdb"
+	"github.com/Onther-Tech/plasma-evm/event"
+	"github.com/Onther-Tech/plasma-evm/log"
+	"github.com/Onther-Tech/plasma-evm/rpc"
+)
+
+const (
+	rootchainEventRetry = 10 * time.Second // Delay before the index resubscribes to the rootchain after a failure
+	rootchainEventPoll  = 15 * time.Second // Interval of polling a rootchain without subscriptions for new events
+	rootchainEventRange = 1000             // Number of rootchain blocks filtered for past events at once
+)
+
+// rootchainEventTypes are the RootChain contract events kept in the index.
+var rootchainEventTypes = map[string]reflect.Type{
+	"BlockFinalized":    reflect.TypeOf(rootchain.RootChainBlockFinalized{}),
+	"BlockSubmitted":    reflect.TypeOf(rootchain.RootChainBlockSubmitted{}),
+	"ERUCreated":        reflect.TypeOf(rootchain.RootChainERUCreated{}),
+	"EpochFilled":       reflect.TypeOf(rootchain.RootChainEpochFilled{}),
+	"EpochFilling":      reflect.TypeOf(rootchain.RootChainEpochFilling{}),
+	"EpochFinalized":    reflect.TypeOf(rootchain.RootChainEpochFinalized{}),
+	"EpochPrepared":     reflect.TypeOf(rootchain.RootChainEpochPrepared{}),
+	"EpochRebased":      reflect.TypeOf(rootchain.RootChainEpochRebased{}),
+	"Forked":            reflect.TypeOf(rootchain.RootChainForked{}),
+	"RequestApplied":    reflect.TypeOf(rootchain.RootChainRequestApplied{}),
+	"RequestChallenged": reflect.TypeOf(rootchain.RootChainRequestChallenged{}),
+	"RequestCreated":    reflect.TypeOf(rootchain.RootChainRequestCreated{}),
+	"RequestFinalized":  reflect.TypeOf(rootchain.RootChainRequestFinalized{}),
+	"SessionTimeout":    reflect.TypeOf(rootchain.RootChainSessionTimeout{}),
+}
+
+// RootChainEvent is an indexed RootChain contract event with its decoded fields.
+type RootChainEvent struct {
+	Type        string          `json:"type"`
+	BlockNumber hexutil.Uint64  `json:"blockNumber"`
+	BlockHash   common.Hash     `json:"blockHash"`
+	TxHash      common.Hash     `json:"transactionHash"`
+	LogIndex    hexutil.Uint64  `json:"logIndex"`
+	Fields      json.RawMessage `json:"fields"`
+}
+
+// RootChainEventFilter selects indexed RootChain contract events. Fork, epoch
+// and requestor only match events carrying the respective field; the block
+// range refers to rootchain blocks.
+type RootChainEventFilter struct {
+	Types     []string        `json:"types"`
+	Fork      *hexutil.Uint64 `json:"fork"`
+	Epoch     *hexutil.Uint64 `json:"epoch"`
+	Requestor *common.Address `json:"requestor"`
+	FromBlock *hexutil.Uint64 `json:"fromBlock"`
+	ToBlock   *hexutil.Uint64 `json:"toBlock"`
+}
+
+var (
+	rootchainEventForkFields      = []string{"Fork", "ForkNumber", "NewFork"}
+	rootchainEventEpochFields     = []string{"EpochNumber"}
+	rootchainEventRequestorFields = []string{"Requestor"}
+)
+
+// indexedRootchainEvent is a RootChain contract event added to the index, at
+// its position in the list of all indexed events.
+type indexedRootchainEvent struct {
+	position uint64
+	name     string
+	event    interface{} // Decoded event binding, e.g. *rootchain.RootChainEpochPrepared
+}
+
+// rootchainEventIndex keeps a local index of the RootChain contract events, so
+// that rootchain history can be queried without filtering the rootchain.
+type rootchainEventIndex struct {
+	db       ethdb.Database
+	backend  *ethclient.Client
+	address  common.Address
+	contract *bind.BoundContract
+	names    map[common.Hash]string // event ID => event name
+	ids      map[string]common.Hash // event name => event ID
+	changes  uint64                 // Number of index updates, accessed atomically
+
+	feed  event.Feed // Feed of the indexed events
+	scope event.SubscriptionScope
+
+	lock sync.RWMutex // Protects the index against concurrent updates and queries
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newRootchainEventIndex(db ethdb.Database, backend *ethclient.Client, address common.Address) *rootchainEventIndex {
+	names := make(map[common.Hash]string)
+	ids := make(map[string]common.Hash)
+	for name := range rootchainEventTypes {
+		if event, ok := rootchainContractABI.Events[name]; ok {
+			names[event.Id()] = name
+			ids[name] = event.Id()
+		}
+	}
+	return &rootchainEventIndex{
+		db:       db,
+		backend:  backend,
+		address:  address,
+		contract: bind.NewBoundContract(address, rootchainContractABI, backend, backend, backend),
+		names:    names,
+		ids:      ids,
+		quit:     make(chan struct{}),
+	}
+}
+
+func (idx *rootchainEventIndex) Start() {
+	idx.wg.Add(1)
+	go idx.loop()
+}
+
+func (idx *rootchainEventIndex) Stop() {
+	close(idx.quit)
+	idx.wg.Wait()
+	idx.scope.Close()
+}
+
+// loop keeps the index in sync with the rootchain, resubscribing on failures.
+func (idx *rootchainEventIndex) loop() {
+	defer idx.wg.Done()
+
+	for {
+		err := idx.sync()
+		select {
+		case <-idx.quit:
+			return
+		default:
+		}
+		log.Error("Rootchain event index interrupted", "err", err)
+
+		select {
+		case <-time.After(rootchainEventRetry):
+		case <-idx.quit:
+			return
+		}
+	}
+}
+
+// sync indexes the past events from the last indexed rootchain block and then
+// follows the new ones until the subscription fails or the index is stopped.
+// Rootchain endpoints without subscriptions, e.g. HTTP, are polled instead.
+func (idx *rootchainEventIndex) sync() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-idx.quit:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	if _, err := idx.catchUp(ctx); err != nil {
+		return err
+	}
+	logs := make(chan types.Log, 128)
+	sub, err := idx.backend.SubscribeFilterLogs(ctx, ethereum.FilterQuery{Addresses: []common.Address{idx.address}}, logs)
+	if err == rpc.ErrNotificationsUnsupported {
+		log.Info("Polling rootchain events", "interval", rootchainEventPoll)
+		return idx.poll(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	// Index the events of the blocks mined before the subscription
+	head, err := idx.catchUp(ctx)
+	if err != nil {
+		return err
+	}
+	for {
+		select {
+		case l := <-logs:
+			if l.BlockNumber <= head && !l.Removed {
+				continue
+			}
+			var added []*indexedRootchainEvent
+
+			idx.lock.Lock()
+			if l.Removed {
+				idx.truncate(l.BlockNumber)
+				if progress := rawdb.ReadRootChainEventProgress(idx.db, idx.address); progress >= l.BlockNumber {
+					rawdb.WriteRootChainEventProgress(idx.db, idx.address, l.BlockNumber-1)
+				}
+			} else {
+				// All events of the previous blocks have been delivered
+				if progress := rawdb.ReadRootChainEventProgress(idx.db, idx.address); progress+1 < l.BlockNumber {
+					rawdb.WriteRootChainEventProgress(idx.db, idx.address, l.BlockNumber-1)
+				}
+				if event := idx.append(l); event != nil {
+					added = append(added, event)
+				}
+			}
+			idx.lock.Unlock()
+
+			idx.send(added)
+
+		case err := <-sub.Err():
+			return err
+
+		case <-idx.quit:
+			return nil
+		}
+	}
+}
+
+// poll indexes the new events periodically until it fails or the index is
+// stopped.
+func (idx *rootchainEventIndex) poll(ctx context.Context) error {
+	for {
+		select {
+		case <-time.After(rootchainEventPoll):
+			if _, err := idx.catchUp(ctx); err != nil {
+				return err
+			}
+		case <-idx.quit:
+			return nil
+		}
+	}
+}
+
+// catchUp indexes the events from the last indexed rootchain block up to the
+// current head, filtering at most rootchainEventRange blocks at once. It returns
+// the last indexed block.
+func (idx *rootchainEventIndex) catchUp(ctx context.Context) (uint64, error) {
+	header, err := idx.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	head := header.Number.Uint64()
+
+	progress := rawdb.ReadRootChainEventProgress(idx.db, idx.address)
+	for from := progress + 1; from <= head; from += rootchainEventRange {
+		to := from + rootchainEventRange - 1
+		if to > head {
+			to = head
+		}
+		past, err := idx.backend.FilterLogs(ctx, ethereum.FilterQuery{
+			Addresses: []common.Address{idx.address},
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+		})
+		if err != nil {
+			return 0, err
+		}
+		var added []*indexedRootchainEvent
+
+		idx.lock.Lock()
+		idx.truncate(from)
+		for _, l := range past {
+			if event := idx.append(l); event != nil {
+				added = append(added, event)
+			}
+		}
+		rawdb.WriteRootChainEventProgress(idx.db, idx.address, to)
+		idx.lock.Unlock()
+
+		idx.send(added)
+
+		log.Debug("Indexed rootchain events", "from", from, "to", to, "events", len(past))
+	}
+	if head > progress {
+		log.Info("Indexed rootchain events", "from", progress+1, "to", head)
+		return head, nil
+	}
+	return progress, nil
+}
+
+// append decodes a rootchain log and adds it to the index. Logs of events not
+// kept in the index are skipped.
+//
+// Note, this function assumes that the `lock` mutex is held!
+func (idx *rootchainEventIndex) append(l types.Log) *indexedRootchainEvent {
+	if len(l.Topics) == 0 {
+		return nil
+	}
+	name, ok := idx.names[l.Topics[0]]
+	if !ok {
+		return nil
+	}
+	out, err := idx.decode(name, l)
+	if err != nil {
+		log.Error("Failed to decode rootchain event", "name", name, "tx", l.TxHash, "err", err)
+		return nil
+	}
+	v := reflect.ValueOf(out).Elem()
+	fields, err := json.Marshal(rootchainEventFields(v))
+	if err != nil {
+		log.Error("Failed to encode rootchain event", "name", name, "tx", l.TxHash, "err", err)
+		return nil
+	}
+	event := &rawdb.RootChainEvent{
+		Name:        name,
+		BlockNumber: l.BlockNumber,
+		BlockHash:   l.BlockHash,
+		TxHash:      l.TxHash,
+		LogIndex:    uint64(l.Index),
+		Topics:      l.Topics,
+		Data:        l.Data,
+		Fields:      fields,
+	}
+	if fork, ok := rootchainEventField(v, rootchainEventForkFields).(*big.Int); ok && fork != nil {
+		event.Fork = fork.Uint64()
+	}
+	if epoch, ok := rootchainEventField(v, rootchainEventEpochFields).(*big.Int); ok && epoch != nil {
+		event.Epoch = epoch.Uint64()
+	}
+	if requestor, ok := rootchainEventField(v, rootchainEventRequestorFields).(common.Address); ok {
+		event.Requestor = requestor
+	}
+	// Add the event to the list of all events and the list of its type
+	position := rawdb.ReadRootChainEventCount(idx.db, idx.address, common.Hash{})
+	for _, id := range []common.Hash{{}, l.Topics[0]} {
+		count := rawdb.ReadRootChainEventCount(idx.db, idx.address, id)
+		rawdb.WriteRootChainEvent(idx.db, idx.address, id, count, event)
+		rawdb.WriteRootChainEventCount(idx.db, idx.address, id, count+1)
+	}
+	atomic.AddUint64(&idx.changes, 1)
+
+	return &indexedRootchainEvent{position: position, name: name, event: out}
+}
+
+// decode unpacks a rootchain log into the binding of the named event.
+func (idx *rootchainEventIndex) decode(name string, l types.Log) (interface{}, error) {
+	out := reflect.New(rootchainEventTypes[name])
+	if err := idx.contract.UnpackLog(out.Interface(), name, l); err != nil {
+		return nil, err
+	}
+	out.Elem().FieldByName("Raw").Set(reflect.ValueOf(l))
+	return out.Interface(), nil
+}
+
+// truncate removes the indexed events emitted at or after the given rootchain
+// block.
+//
+// Note, this function assumes that the `lock` mutex is held!
+func (idx *rootchainEventIndex) truncate(number uint64) {
+	count := rawdb.ReadRootChainEventCount(idx.db, idx.address, common.Hash{})
+	for count > 0 {
+		event := rawdb.ReadRootChainEvent(idx.db, idx.address, common.Hash{}, count-1)
+		if event != nil && event.BlockNumber < number {
+			break
+		}
+		count--
+		rawdb.DeleteRootChainEvent(idx.db, idx.address, common.Hash{}, count)
+
+		// The event is also the last one of its type
+		if event != nil && len(event.Topics) > 0 {
+			id := event.Topics[0]
+			if n := rawdb.ReadRootChainEventCount(idx.db, idx.address, id); n > 0 {
+				rawdb.DeleteRootChainEvent(idx.db, idx.address, id, n-1)
+				rawdb.WriteRootChainEventCount(idx.db, idx.address, id, n-1)
+			}
+		}
+		atomic.AddUint64(&idx.changes, 1)
+	}
+	rawdb.WriteRootChainEventCount(idx.db, idx.address, common.Hash{}, count)
+}
+
+// send delivers the added events to the subscribers.
+func (idx *rootchainEventIndex) send(events []*indexedRootchainEvent) {
+	for _, event := range events {
+		idx.feed.Send(event)
+	}
+}
+
+// changeCount returns the number of events added to or removed from the index,
+// so that the users of rootchain data know when to refresh it.
+func (idx *rootchainEventIndex) changeCount() uint64 {
+	return atomic.LoadUint64(&idx.changes)
+}
+
+// decoded returns the indexed events of the given types decoded into their
+// bindings, in rootchain order.
+func (idx *rootchainEventIndex) decoded(names ...string) ([]interface{}, error) {
+	idx.lock.RLock()
+	defer idx.lock.RUnlock()
+
+	return idx.decodedLocked(names)
+}
+
+// decodedLocked is decoded with the `lock` mutex held.
+func (idx *rootchainEventIndex) decodedLocked(names []string) ([]interface{}, error) {
+	events, err := idx.lookup(names, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]interface{}, 0, len(events))
+	for _, event := range events {
+		out, err := idx.decode(event.Name, types.Log{
+			Address:     idx.address,
+			Topics:      event.Topics,
+			Data:        event.Data,
+			BlockNumber: event.BlockNumber,
+			TxHash:      event.TxHash,
+			BlockHash:   event.BlockHash,
+			Index:       uint(event.LogIndex),
+		})
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, out)
+	}
+	return result, nil
+}
+
+// watch returns the indexed events of the given types decoded into their
+// bindings, and subscribes ch to the ones indexed afterwards.
+func (idx *rootchainEventIndex) watch(ch chan<- interface{}, names ...string) ([]interface{}, event.Subscription, error) {
+	wanted := make(map[string]bool)
+	for _, name := range names {
+		wanted[name] = true
+	}
+	added := make(chan *indexedRootchainEvent, 128)
+
+	// Subscribe before reading the index, the events added in between are
+	// skipped by their position
+	idx.lock.RLock()
+	sub := idx.scope.Track(idx.feed.Subscribe(added))
+	next := rawdb.ReadRootChainEventCount(idx.db, idx.address, common.Hash{})
+	past, err := idx.decodedLocked(names)
+	idx.lock.RUnlock()
+
+	if err != nil {
+		sub.Unsubscribe()
+		return nil, nil, err
+	}
+	return past, event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case e := <-added:
+				if e.position < next || !wanted[e.name] {
+					continue
+				}
+				select {
+				case ch <- e.event:
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// events returns the indexed events matching the filter in rootchain order.
+func (idx *rootchainEventIndex) events(filter RootChainEventFilter) ([]*RootChainEvent, error) {
+	if filter.FromBlock != nil && filter.ToBlock != nil && *filter.FromBlock > *filter.ToBlock {
+		return nil, errors.New("fromBlock is greater than toBlock")
+	}
+	idx.lock.RLock()
+	defer idx.lock.RUnlock()
+
+	events, err := idx.lookup(filter.Types, filter.FromBlock, filter.ToBlock)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*RootChainEvent, 0)
+	for _, event := range events {
+		if !filter.matchFields(event) {
+			continue
+		}
+		result = append(result, &RootChainEvent{
+			Type:        event.Name,
+			BlockNumber: hexutil.Uint64(event.BlockNumber),
+			BlockHash:   event.BlockHash,
+			TxHash:      event.TxHash,
+			LogIndex:    hexutil.Uint64(event.LogIndex),
+			Fields:      json.RawMessage(event.Fields),
+		})
+	}
+	return result, nil
+}
+
+// lookup returns the indexed events of the given types, or of all types if none
+// is given, emitted in the rootchain block range. Events are read from the list
+// of each type, starting at the first one in the range.
+//
+// Note, this function assumes that the `lock` mutex is held!
+func (idx *rootchainEventIndex) lookup(names []string, from, to *hexutil.Uint64) ([]*rawdb.RootChainEvent, error) {
+	ids := []common.Hash{{}}
+	if len(names) > 0 {
+		ids = ids[:0]
+		for _, name := range names {
+			id, ok := idx.ids[name]
+			if !ok {
+				return nil, errors.New("unknown rootchain event type " + name)
+			}
+			ids = append(ids, id)
+		}
+	}
+	var events []*rawdb.RootChainEvent
+	for _, id := range ids {
+		// Events are ordered by rootchain block, skip the ones before the range
+		count := rawdb.ReadRootChainEventCount(idx.db, idx.address, id)
+		start := uint64(0)
+		if from != nil {
+			start = uint64(sort.Search(int(count), func(i int) bool {
+				event := rawdb.ReadRootChainEvent(idx.db, idx.address, id, uint64(i))
+				return event == nil || event.BlockNumber >= uint64(*from)
+			}))
+		}
+		for i := start; i < count; i++ {
+			event := rawdb.ReadRootChainEvent(idx.db, idx.address, id, i)
+			if event == nil {
+				continue
+			}
+			if to != nil && event.BlockNumber > uint64(*to) {
+				break
+			}
+			events = append(events, event)
+		}
+	}
+	if len(ids) > 1 {
+		sort.SliceStable(events, func(i, j int) bool {
+			if events[i].BlockNumber != events[j].BlockNumber {
+				return events[i].BlockNumber < events[j].BlockNumber
+			}
+			return events[i].LogIndex < events[j].LogIndex
+		})
+	}
+	return events, nil
+}
+
+// matchFields reports whether the event satisfies the fork, epoch and requestor
+// criteria of the filter. Events without the respective field never match.
+func (filter RootChainEventFilter) matchFields(event *rawdb.RootChainEvent) bool {
+	typ := rootchainEventTypes[event.Name]
+	if filter.Fork != nil {
+		if !hasRootchainEventField(typ, rootchainEventForkFields) || event.Fork != uint64(*filter.Fork) {
+			return false
+		}
+	}
+	if filter.Epoch != nil {
+		if !hasRootchainEventField(typ, rootchainEventEpochFields) || event.Epoch != uint64(*filter.Epoch) {
+			return false
+		}
+	}
+	if filter.Requestor != nil {
+		if !hasRootchainEventField(typ, rootchainEventRequestorFields) || event.Requestor != *filter.Requestor {
+			return false
+		}
+	}
+	return true
+}
+
+// hasRootchainEventField reports whether the event binding has any of the fields.
+func hasRootchainEventField(typ reflect.Type, names []string) bool {
+	for _, name := range names {
+		if _, ok := typ.FieldByName(name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// rootchainEventField returns the value of the first of the fields found in the
+// decoded event, or nil if it has none of them.
+func rootchainEventField(v reflect.Value, names []string) interface{} {
+	for _, name := range names {
+		if field := v.FieldByName(name); field.IsValid() {
+			return field.Interface()
+		}
+	}
+	return nil
+}
+
+// rootchainEventFields converts a decoded event into its JSON fields, keyed by
+// the lower camel case field names of the binding.
+func rootchainEventFields(v reflect.Value) map[string]interface{} {
+	fields := make(map[string]interface{})
+	for i := 0; i < v.NumField(); i++ {
+		name := v.Type().Field(i).Name
+		if name == "Raw" {
+			continue
+		}
+		key := strings.Map(unicode.ToLower, name[:1]) + name[1:]
+
+		switch value := v.Field(i).Interface().(type) {
+		case *big.Int:
+			fields[key] = (*hexutil.Big)(value)
+		case [32]byte:
+			fields[key] = common.Hash(value)
+		default:
+			fields[key] = value
+		}
+	}
+	return fields
+}
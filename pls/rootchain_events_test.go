@@ -0,0 +1,277 @@
+package pls
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/common/hexutil"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/core/rawdb"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/ethdb"
+)
+
+// addEvent adds a RootChain contract event emitted at the rootchain block.
+func (b *testRootChainBackend) addEvent(t *testing.T, name string, number uint64, args ...interface{}) {
+	event := b.ABI.Events[name]
+	data, err := event.Inputs.Pack(args...)
+	if err != nil {
+		t.Fatalf("failed to pack event: %v", err)
+	}
+	b.Lock()
+	defer b.Unlock()
+
+	b.Logs = append(b.Logs, types.Log{
+		Topics:      []common.Hash{event.Id()},
+		Data:        data,
+		BlockNumber: number,
+		TxHash:      common.BigToHash(new(big.Int).SetUint64(number)),
+		Index:       uint(len(b.Logs)),
+	})
+}
+
+// addBlockFinalized adds a BlockFinalized event emitted at the rootchain block.
+func (b *testRootChainBackend) addBlockFinalized(t *testing.T, number uint64) {
+	b.addEvent(t, "BlockFinalized", number, big.NewInt(0), new(big.Int).SetUint64(number))
+}
+
+// addEpochPrepared adds an EpochPrepared event of the fork and epoch emitted at
+// the rootchain block.
+func (b *testRootChainBackend) addEpochPrepared(t *testing.T, number uint64, fork, epoch int64) {
+	b.addEvent(t, "EpochPrepared", number, big.NewInt(fork), big.NewInt(epoch), big.NewInt(1), big.NewInt(1), big.NewInt(0), big.NewInt(0), false, false, false, false)
+}
+
+// eventNumbers returns the rootchain block numbers of the events.
+func eventNumbers(events []*RootChainEvent) []uint64 {
+	numbers := make([]uint64, len(events))
+	for i, event := range events {
+		numbers[i] = uint64(event.BlockNumber)
+	}
+	return numbers
+}
+
+// Tests that the past events are indexed in bounded block ranges, and that the
+// index resumes from the last indexed block.
+func TestRootchainEventIndexCatchUp(t *testing.T) {
+	backend := newTestRootChainBackend(rootchainContractABI)
+	for _, number := range []uint64{5, 1500, 2500} {
+		backend.addBlockFinalized(t, number)
+	}
+	backend.Head = 2500
+
+	db := ethdb.NewMemDatabase()
+	idx := newRootchainEventIndex(db, backend.Client(), common.Address{})
+
+	check := func(head uint64, queries [][2]uint64, events []uint64) {
+		t.Helper()
+
+		backend.Lock()
+		backend.Queries = nil
+		backend.Head = head
+		backend.Unlock()
+
+		indexed, err := idx.catchUp(context.Background())
+		if err != nil {
+			t.Fatalf("failed to index events: %v", err)
+		}
+		if indexed != head {
+			t.Errorf("indexed head mismatch: have %d, want %d", indexed, head)
+		}
+		if progress := rawdb.ReadRootChainEventProgress(db, common.Address{}); progress != head {
+			t.Errorf("progress mismatch: have %d, want %d", progress, head)
+		}
+		backend.Lock()
+		if !reflect.DeepEqual(backend.Queries, queries) {
+			t.Errorf("queries mismatch: have %v, want %v", backend.Queries, queries)
+		}
+		backend.Unlock()
+
+		indexedEvents, err := idx.events(RootChainEventFilter{})
+		if err != nil {
+			t.Fatalf("failed to query events: %v", err)
+		}
+		if numbers := eventNumbers(indexedEvents); !reflect.DeepEqual(numbers, events) {
+			t.Errorf("indexed events mismatch: have %v, want %v", numbers, events)
+		}
+	}
+	check(2500, [][2]uint64{{1, 1000}, {1001, 2000}, {2001, 2500}}, []uint64{5, 1500, 2500})
+
+	backend.addBlockFinalized(t, 2550)
+	check(2600, [][2]uint64{{2501, 2600}}, []uint64{5, 1500, 2500, 2550})
+
+	// Nothing is filtered without new rootchain blocks
+	check(2600, nil, []uint64{5, 1500, 2500, 2550})
+}
+
+// Tests that indexed events are looked up by type, block range and decoded
+// fields, and that the index of each RootChain contract is kept apart.
+func TestRootchainEventIndexLookup(t *testing.T) {
+	backend := newTestRootChainBackend(rootchainContractABI)
+	backend.addBlockFinalized(t, 5)
+	backend.addEpochPrepared(t, 7, 1, 2)
+	backend.addBlockFinalized(t, 9)
+	backend.addEpochPrepared(t, 9, 1, 3)
+	backend.Head = 10
+
+	db := ethdb.NewMemDatabase()
+	idx := newRootchainEventIndex(db, backend.Client(), common.Address{})
+	if _, err := idx.catchUp(context.Background()); err != nil {
+		t.Fatalf("failed to index events: %v", err)
+	}
+	number := func(n uint64) *hexutil.Uint64 { return (*hexutil.Uint64)(&n) }
+	requestor := common.HexToAddress("0x01")
+
+	tests := []struct {
+		filter RootChainEventFilter
+		want   []uint64
+	}{
+		{RootChainEventFilter{}, []uint64{5, 7, 9, 9}},
+		{RootChainEventFilter{Types: []string{"BlockFinalized"}}, []uint64{5, 9}},
+		{RootChainEventFilter{Types: []string{"EpochPrepared", "BlockFinalized"}}, []uint64{5, 7, 9, 9}},
+		{RootChainEventFilter{Types: []string{"EpochPrepared"}, FromBlock: number(8)}, []uint64{9}},
+		{RootChainEventFilter{FromBlock: number(6), ToBlock: number(8)}, []uint64{7}},
+		{RootChainEventFilter{Fork: number(1)}, []uint64{7, 9}},
+		{RootChainEventFilter{Fork: number(0)}, []uint64{5, 9}},
+		{RootChainEventFilter{Epoch: number(3)}, []uint64{9}},
+		{RootChainEventFilter{Requestor: &requestor}, []uint64{}},
+	}
+	for i, tt := range tests {
+		events, err := idx.events(tt.filter)
+		if err != nil {
+			t.Fatalf("test %d: failed to query events: %v", i, err)
+		}
+		if numbers := eventNumbers(events); !reflect.DeepEqual(numbers, tt.want) {
+			t.Errorf("test %d: events mismatch: have %v, want %v", i, numbers, tt.want)
+		}
+	}
+	if _, err := idx.events(RootChainEventFilter{Types: []string{"Unknown"}}); err == nil {
+		t.Error("unknown event type accepted")
+	}
+	// Truncation removes the events from the lists of their types too
+	idx.lock.Lock()
+	idx.truncate(8)
+	idx.lock.Unlock()
+
+	if events, _ := idx.events(RootChainEventFilter{Types: []string{"BlockFinalized", "EpochPrepared"}}); !reflect.DeepEqual(eventNumbers(events), []uint64{5, 7}) {
+		t.Errorf("truncated events mismatch: have %v, want %v", eventNumbers(events), []uint64{5, 7})
+	}
+	// The index of another contract in the same database is empty
+	other := newRootchainEventIndex(db, backend.Client(), common.HexToAddress("0x02"))
+	if events, _ := other.events(RootChainEventFilter{}); len(events) != 0 {
+		t.Errorf("events of other contract mismatch: have %v, want none", eventNumbers(events))
+	}
+}
+
+// Tests that watchers get the indexed events of their types decoded, and then
+// the ones indexed afterwards exactly once.
+func TestRootchainEventIndexWatch(t *testing.T) {
+	backend := newTestRootChainBackend(rootchainContractABI)
+	backend.addBlockFinalized(t, 5)
+	backend.addEpochPrepared(t, 7, 0, 1)
+	backend.Head = 10
+
+	idx := newRootchainEventIndex(ethdb.NewMemDatabase(), backend.Client(), common.Address{})
+	if _, err := idx.catchUp(context.Background()); err != nil {
+		t.Fatalf("failed to index events: %v", err)
+	}
+	ch := make(chan interface{}, 4)
+	past, sub, err := idx.watch(ch, "EpochPrepared")
+	if err != nil {
+		t.Fatalf("failed to watch events: %v", err)
+	}
+	defer sub.Unsubscribe()
+
+	if len(past) != 1 {
+		t.Fatalf("past events mismatch: have %d, want 1", len(past))
+	}
+	if e, ok := past[0].(*rootchain.RootChainEpochPrepared); !ok || e.EpochNumber.Uint64() != 1 || e.Raw.BlockNumber != 7 {
+		t.Fatalf("past event mismatch: have %+v", past[0])
+	}
+	backend.addBlockFinalized(t, 11)
+	backend.addEpochPrepared(t, 12, 0, 2)
+	backend.Head = 12
+	if _, err := idx.catchUp(context.Background()); err != nil {
+		t.Fatalf("failed to index events: %v", err)
+	}
+	select {
+	case e := <-ch:
+		if e, ok := e.(*rootchain.RootChainEpochPrepared); !ok || e.EpochNumber.Uint64() != 2 {
+			t.Fatalf("new event mismatch: have %+v", e)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("new event not delivered")
+	}
+	select {
+	case e := <-ch:
+		t.Fatalf("unexpected event delivered: %+v", e)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// Tests that the index stops promptly, even if the rootchain can't be followed.
+func TestRootchainEventIndexStop(t *testing.T) {
+	backend := newTestRootChainBackend(rootchainContractABI)
+	idx := newRootchainEventIndex(ethdb.NewMemDatabase(), backend.Client(), common.Address{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := idx.catchUp(ctx); err == nil {
+		t.Error("events indexed with a cancelled context")
+	}
+
+	idx.Start()
+	stopped := make(chan struct{})
+	go func() {
+		idx.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("event index not stopped")
+	}
+}
+
+// Tests that the plasma status is only read from the RootChain contract again
+// once a rootchain event is indexed.
+func TestPlasmaStatusCache(t *testing.T) {
+	backend := newTestRootChainBackend(rootchainContractABI)
+	var reads int
+	backend.Handle("getNumEROs", func([]interface{}) ([]interface{}, error) {
+		backend.Lock()
+		reads++
+		backend.Unlock()
+		return []interface{}{big.NewInt(0)}, nil
+	})
+	backend.Head = 10
+
+	rcm := backend.manager(&Config{})
+	rcm.state = &rootchainState{rcm: rcm}
+	rcm.submissions = new(submissionStats)
+	rcm.events = newRootchainEventIndex(ethdb.NewMemDatabase(), backend.Client(), common.Address{})
+
+	status := func(want int) {
+		t.Helper()
+		if _, err := rcm.Status(); err != nil {
+			t.Fatalf("failed to get status: %v", err)
+		}
+		backend.Lock()
+		defer backend.Unlock()
+		if reads != want {
+			t.Errorf("status reads mismatch: have %d, want %d", reads, want)
+		}
+	}
+	status(1)
+	status(1)
+
+	backend.addBlockFinalized(t, 10)
+	if _, err := rcm.events.catchUp(context.Background()); err != nil {
+		t.Fatalf("failed to index events: %v", err)
+	}
+	status(2)
+	status(2)
+}
@@ -0,0 +1,217 @@
+package pls
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/Onther-Tech/plasma-evm/accounts/abi"
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/common/hexutil"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/token"
+	"github.com/Onther-Tech/plasma-evm/core"
+	"github.com/Onther-Tech/plasma-evm/core/state"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/core/vm"
+	"github.com/Onther-Tech/plasma-evm/log"
+	"github.com/Onther-Tech/plasma-evm/params"
+)
+
+var requestableTokenABI, _ = abi.JSON(strings.NewReader(token.RequestableSimpleTokenABI))
+
+// ExitRequest is an unsigned startExit call to the RootChain contract, or a
+// makeERU call if the request is user activated.
+type ExitRequest struct {
+	Requestor     common.Address `json:"requestor"`
+	To            common.Address `json:"to"`
+	TrieKey       common.Hash    `json:"trieKey"`
+	TrieValue     common.Hash    `json:"trieValue"`
+	UserActivated bool           `json:"userActivated"`
+	Value         *hexutil.Big   `json:"value"`
+	Data          hexutil.Bytes  `json:"data"`
+}
+
+// ExitRequests is the ordered set of exit requests for all assets of an account
+// at a finalized plasma block.
+type ExitRequests struct {
+	ForkNumber  hexutil.Uint64 `json:"forkNumber"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	StatesRoot  common.Hash    `json:"statesRoot"`
+	Requests    []*ExitRequest `json:"requests"`
+}
+
+// exitRequests walks the ETH balance and the requestable token balances of the
+// requestor at the given finalized block. If number is nil, the last finalized
+// block of the current fork is used.
+func (rcm *RootChainManager) exitRequests(requestor common.Address, number *uint64) (*ExitRequests, error) {
+	fork := new(big.Int).SetUint64(rcm.state.getCurrentFork())
+
+	lastFinalized, err := rcm.rootchainContract.GetLastFinalizedBlock(baseCallOpt, fork)
+	if err != nil {
+		return nil, err
+	}
+	blockNumber := lastFinalized.Uint64()
+	if number != nil {
+		if *number > blockNumber {
+			return nil, fmt.Errorf("block %d is not finalized, last finalized block is %d", *number, blockNumber)
+		}
+		blockNumber = *number
+	}
+
+	pb, err := rcm.getBlock(fork, new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		return nil, err
+	}
+	block := rcm.blockchain.GetBlockByNumber(blockNumber)
+	if block == nil {
+		return nil, fmt.Errorf("block %d is not available", blockNumber)
+	}
+	if block.Root() != common.Hash(pb.StatesRoot) {
+		return nil, fmt.Errorf("block %d state root mismatch: local %s, rootchain %s", blockNumber, block.Root().Hex(), common.Hash(pb.StatesRoot).Hex())
+	}
+
+	statedb, err := rcm.blockchain.StateAt(block.Root())
+	if err != nil {
+		return nil, err
+	}
+	contracts, err := rcm.requestableContracts()
+	if err != nil {
+		return nil, err
+	}
+	requests, err := rcm.makeExitRequests(requestor, statedb, block.Header(), contracts, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExitRequests{
+		ForkNumber:  hexutil.Uint64(fork.Uint64()),
+		BlockNumber: hexutil.Uint64(blockNumber),
+		StatesRoot:  block.Root(),
+		Requests:    requests,
+	}, nil
+}
+
+// makeExitRequests builds the exit requests of the requestor against the given
+// state, as EROs or as ERUs if userActivated is set. The ETH exit comes first,
+// followed by the token exits ordered by the rootchain address of the requestable
+// contract.
+func (rcm *RootChainManager) makeExitRequests(requestor common.Address, statedb *state.StateDB, header *types.Header, contracts map[common.Address]common.Address, userActivated bool) ([]*ExitRequest, error) {
+	var (
+		cost     = (*hexutil.Big)(new(big.Int).SetUint64(rcm.state.costERO))
+		requests []*ExitRequest
+	)
+	if userActivated {
+		cost = (*hexutil.Big)(new(big.Int).SetUint64(rcm.state.costERU))
+	}
+
+	if balance := statedb.GetBalance(requestor); balance.Sign() > 0 {
+		request, err := newExitRequest(requestor, requestor, common.Hash{}, common.BigToHash(balance), cost, userActivated)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+
+	roots := make([]common.Address, 0, len(contracts))
+	for root := range contracts {
+		roots = append(roots, root)
+	}
+	sort.Slice(roots, func(i, j int) bool { return bytes.Compare(roots[i][:], roots[j][:]) < 0 })
+
+	for _, root := range roots {
+		child := contracts[root]
+
+		trieKey, err := rcm.balanceTrieKey(statedb, header, child, requestor)
+		if err != nil {
+			log.Debug("Skipping requestable contract without balance trie key", "rootchain", root, "childchain", child, "err", err)
+			continue
+		}
+		trieValue := statedb.GetState(child, trieKey)
+		if trieValue == (common.Hash{}) {
+			continue
+		}
+		request, err := newExitRequest(requestor, root, trieKey, trieValue, cost, userActivated)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
+
+func newExitRequest(requestor, to common.Address, trieKey, trieValue common.Hash, cost *hexutil.Big, userActivated bool) (*ExitRequest, error) {
+	method := "startExit"
+	if userActivated {
+		method = "makeERU"
+	}
+	data, err := rootchainContractABI.Pack(method, to, trieKey, trieValue)
+	if err != nil {
+		return nil, err
+	}
+	return &ExitRequest{
+		Requestor:     requestor,
+		To:            to,
+		TrieKey:       trieKey,
+		TrieValue:     trieValue,
+		UserActivated: userActivated,
+		Value:         cost,
+		Data:          data,
+	}, nil
+}
+
+// requestableContracts returns the requestable contracts used by requests so
+// far, mapped from the rootchain address to the childchain address.
+func (rcm *RootChainManager) requestableContracts() (map[common.Address]common.Address, error) {
+	events, err := rcm.events.decoded("RequestCreated")
+	if err != nil {
+		return nil, err
+	}
+	contracts := make(map[common.Address]common.Address)
+	for _, event := range events {
+		e := event.(*rootchain.RootChainRequestCreated)
+		if e.IsTransfer {
+			continue
+		}
+		if _, ok := contracts[e.To]; ok {
+			continue
+		}
+		child, err := rcm.rootchainContract.RequestableContracts(baseCallOpt, e.To)
+		if err != nil {
+			return nil, err
+		}
+		if child != (common.Address{}) {
+			contracts[e.To] = child
+		}
+	}
+	return contracts, nil
+}
+
+// balanceTrieKey calls getBalanceTrieKey of the requestable contract.
+func (rcm *RootChainManager) balanceTrieKey(statedb *state.StateDB, header *types.Header, contract, who common.Address) (common.Hash, error) {
+	input, err := requestableTokenABI.Pack("getBalanceTrieKey", who)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	output, err := rcm.callContract(statedb.Copy(), header, contract, input)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(output) != common.HashLength {
+		return common.Hash{}, errors.New("invalid trie key")
+	}
+	return common.BytesToHash(output), nil
+}
+
+// callContract executes a read-only call against the given state.
+func (rcm *RootChainManager) callContract(statedb *state.StateDB, header *types.Header, to common.Address, input []byte) ([]byte, error) {
+	msg := types.NewMessage(params.NullAddress, &to, 0, big.NewInt(0), params.RequestTxGasLimit, big.NewInt(0), input, false)
+	context := core.NewEVMContext(msg, header, rcm.blockchain, nil)
+	evm := vm.NewEVM(context, statedb, rcm.blockchain.Config(), vm.Config{})
+
+	output, _, err := evm.StaticCall(vm.AccountRef(msg.From()), to, input, msg.Gas())
+	return output, err
+}
@@ -0,0 +1,94 @@
+package pls
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/consensus/ethash"
+	"github.com/Onther-Tech/plasma-evm/core"
+	"github.com/Onther-Tech/plasma-evm/core/state"
+	"github.com/Onther-Tech/plasma-evm/core/vm"
+	"github.com/Onther-Tech/plasma-evm/ethdb"
+	"github.com/Onther-Tech/plasma-evm/params"
+)
+
+func TestMakeExitRequests(t *testing.T) {
+	db := ethdb.NewMemDatabase()
+	genesis := new(core.Genesis).MustCommit(db)
+	blockchain, err := core.NewBlockChain(db, nil, params.TestChainConfig, ethash.NewFaker(), vm.Config{}, nil)
+	if err != nil {
+		t.Fatalf("failed to create blockchain: %v", err)
+	}
+	defer blockchain.Stop()
+
+	rcm := &RootChainManager{
+		blockchain: blockchain,
+		state:      &rootchainState{costERO: 100, costERU: 200},
+	}
+
+	var (
+		requestor = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		rootToken = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		rootNoKey = common.HexToAddress("0x3000000000000000000000000000000000000003")
+		child     = common.HexToAddress("0x4000000000000000000000000000000000000004")
+		childNone = common.HexToAddress("0x5000000000000000000000000000000000000005")
+		trieKey   = common.BigToHash(big.NewInt(0x2a))
+	)
+
+	statedb, _ := state.New(genesis.Root(), state.NewDatabase(db))
+	statedb.SetBalance(requestor, big.NewInt(1000))
+	// PUSH1 0x2a PUSH1 0 MSTORE PUSH1 0x20 PUSH1 0 RETURN
+	statedb.SetCode(child, common.Hex2Bytes("602a60005260206000f3"))
+	statedb.SetState(child, trieKey, common.BigToHash(big.NewInt(500)))
+
+	contracts := map[common.Address]common.Address{
+		rootToken: child,
+		rootNoKey: childNone,
+	}
+	requests, err := rcm.makeExitRequests(requestor, statedb, genesis.Header(), contracts, false)
+	if err != nil {
+		t.Fatalf("failed to make exit requests: %v", err)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("exit request count mismatch: have %d, want 2", len(requests))
+	}
+
+	eth, tok := requests[0], requests[1]
+	if eth.To != requestor || eth.TrieKey != (common.Hash{}) || eth.TrieValue != common.BigToHash(big.NewInt(1000)) {
+		t.Errorf("ETH exit mismatch: %+v", eth)
+	}
+	if tok.To != rootToken || tok.TrieKey != trieKey || tok.TrieValue != common.BigToHash(big.NewInt(500)) {
+		t.Errorf("token exit mismatch: %+v", tok)
+	}
+	for i, request := range requests {
+		if request.Value.ToInt().Uint64() != 100 {
+			t.Errorf("exit #%d: cost mismatch: have %v, want 100", i, request.Value)
+		}
+		if len(request.Data) != 4+3*32 {
+			t.Errorf("exit #%d: invalid call data length %d", i, len(request.Data))
+		}
+	}
+
+	// ERUs exit the same assets through makeERU at the ERU cost
+	erus, err := rcm.makeExitRequests(requestor, statedb, genesis.Header(), contracts, true)
+	if err != nil {
+		t.Fatalf("failed to make ERUs: %v", err)
+	}
+	if len(erus) != len(requests) {
+		t.Fatalf("ERU count mismatch: have %d, want %d", len(erus), len(requests))
+	}
+	makeERU := rootchainContractABI.Methods["makeERU"].Id()
+	for i, eru := range erus {
+		if !eru.UserActivated || eru.To != requests[i].To || eru.TrieKey != requests[i].TrieKey || eru.TrieValue != requests[i].TrieValue {
+			t.Errorf("ERU #%d mismatch: have %+v, want %+v", i, eru, requests[i])
+		}
+		if eru.Value.ToInt().Uint64() != 200 {
+			t.Errorf("ERU #%d: cost mismatch: have %v, want 200", i, eru.Value)
+		}
+		if !bytes.Equal(eru.Data[:4], makeERU) {
+			t.Errorf("ERU #%d: method mismatch: have %x, want %x", i, eru.Data[:4], makeERU)
+		}
+	}
+}
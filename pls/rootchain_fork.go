@@ -0,0 +1,88 @@
+package pls
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/core"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/log"
+)
+
+// handleForked handles Forked event from RootChain contract.
+func (rcm *RootChainManager) handleForked(e *rootchain.RootChainForked) error {
+	rcm.lock.Lock()
+	defer rcm.lock.Unlock()
+
+	log.Info("RootChain forked", "newFork", e.NewFork, "epochNumber", e.EpochNumber, "forkedBlockNumber", e.ForkedBlockNumber)
+	return rcm.applyFork(e.NewFork.Uint64())
+}
+
+// applyFork rewinds the plasma chain to the first block of the fork, so that
+// the blocks of the fork are mined and submitted from there. User transactions
+// of the rewound blocks are put back into the tx pool. It does nothing if the
+// fork is already applied.
+//
+// The caller must hold rcm.lock.
+func (rcm *RootChainManager) applyFork(forkNumber uint64) error {
+	if forkNumber <= rcm.state.getCurrentFork() {
+		return nil
+	}
+	fork, err := rcm.rootchainContract.Forks(baseCallOpt, new(big.Int).SetUint64(forkNumber))
+	if err != nil {
+		return err
+	}
+	if fork.FirstBlock == 0 {
+		return fmt.Errorf("fork %d has no first block", forkNumber)
+	}
+
+	// Stop mining until the first epoch of the fork is prepared
+	rcm.miner.ResetEpoch()
+	rcm.txPool.ResetRequests()
+
+	current := rcm.blockchain.CurrentBlock()
+	orphaned := orphanedTxs(rcm.blockchain, current, fork.FirstBlock)
+
+	if current.NumberU64() >= fork.FirstBlock {
+		if err := rcm.blockchain.SetHead(fork.FirstBlock - 1); err != nil {
+			return err
+		}
+		// Reset the tx pool and the miner to the rewound head
+		head := rcm.blockchain.CurrentBlock()
+		rcm.blockchain.PostChainEvents([]interface{}{core.ChainHeadEvent{Block: head}}, nil)
+
+		if errs := rcm.txPool.AddRemotes(orphaned); len(errs) != 0 {
+			for i, err := range errs {
+				if err != nil {
+					log.Debug("Failed to reinject orphaned transaction", "hash", orphaned[i].Hash(), "err", err)
+				}
+			}
+		}
+		log.Warn("Plasma chain rewound to fork", "fork", forkNumber, "from", current.NumberU64(), "to", head.NumberU64(), "orphaned", len(orphaned))
+	}
+
+	rcm.withholding.rewind(forkNumber, fork.FirstBlock)
+	rcm.state.setCurrentFork(forkNumber, fork.LastEpoch)
+
+	return nil
+}
+
+// orphanedTxs returns the user transactions in the blocks from the given head
+// down to the block number. Request transactions are not included, as they are
+// applied again from the requests in the RootChain contract.
+func orphanedTxs(bc *core.BlockChain, head *types.Block, number uint64) types.Transactions {
+	var blocks []*types.Block
+	for block := head; block != nil && block.NumberU64() >= number && block.NumberU64() > 0; block = bc.GetBlock(block.ParentHash(), block.NumberU64()-1) {
+		blocks = append(blocks, block)
+	}
+	// Keep the order of the transactions as they were mined
+	var txs types.Transactions
+	for i := len(blocks) - 1; i >= 0; i-- {
+		if blocks[i].IsRequest() {
+			continue
+		}
+		txs = append(txs, blocks[i].Transactions()...)
+	}
+	return txs
+}
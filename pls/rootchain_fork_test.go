@@ -0,0 +1,104 @@
+package pls
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Onther-Tech/plasma-evm/consensus/ethash"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/core"
+	"github.com/Onther-Tech/plasma-evm/core/rawdb"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/core/vm"
+	"github.com/Onther-Tech/plasma-evm/ethdb"
+	"github.com/Onther-Tech/plasma-evm/params"
+)
+
+func TestOrphanedTxs(t *testing.T) {
+	var (
+		db      = ethdb.NewMemDatabase()
+		gspec   = &core.Genesis{Config: params.TestChainConfig, Alloc: core.GenesisAlloc{testBank: {Balance: big.NewInt(1000000000)}}}
+		genesis = gspec.MustCommit(db)
+		signer  = types.HomesteadSigner{}
+	)
+	blocks, _ := core.GenerateChain(gspec.Config, genesis, ethash.NewFaker(), db, 3, func(i int, block *core.BlockGen) {
+		tx, _ := types.SignTx(types.NewTransaction(block.TxNonce(testBank), testBank, big.NewInt(1), params.TxGas, nil, nil), signer, testBankKey)
+		block.AddTx(tx)
+	})
+	blockchain, err := core.NewBlockChain(db, nil, gspec.Config, ethash.NewFaker(), vm.Config{}, nil)
+	if err != nil {
+		t.Fatalf("failed to create blockchain: %v", err)
+	}
+	defer blockchain.Stop()
+
+	for _, block := range blocks {
+		rawdb.WriteBlock(db, block)
+	}
+	head := blocks[len(blocks)-1]
+
+	txs := orphanedTxs(blockchain, head, 2)
+	if len(txs) != 2 {
+		t.Fatalf("orphaned tx count mismatch: have %d, want 2", len(txs))
+	}
+	for i, tx := range txs {
+		if want := blocks[i+1].Transactions()[0].Hash(); tx.Hash() != want {
+			t.Errorf("orphaned tx #%d mismatch: have %x, want %x", i, tx.Hash(), want)
+		}
+	}
+
+	if txs := orphanedTxs(blockchain, head, 4); len(txs) != 0 {
+		t.Errorf("orphaned txs above the head: have %d, want 0", len(txs))
+	}
+}
+
+func TestEpochSubmitted(t *testing.T) {
+	var (
+		db      = ethdb.NewMemDatabase()
+		gspec   = &core.Genesis{Config: params.TestChainConfig}
+		genesis = gspec.MustCommit(db)
+	)
+	blocks, _ := core.GenerateChain(gspec.Config, genesis, ethash.NewFaker(), db, 4, nil)
+	for i, block := range blocks {
+		blocks[i] = block.WithSeal(block.Header())
+	}
+	blockchain, err := core.NewBlockChain(db, nil, gspec.Config, ethash.NewFaker(), vm.Config{}, nil)
+	if err != nil {
+		t.Fatalf("failed to create blockchain: %v", err)
+	}
+	defer blockchain.Stop()
+
+	if _, err := blockchain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert blocks: %v", err)
+	}
+	// NRE#1 of blocks 1-2 and the first block of NRE#3 are submitted, the
+	// request epoch #2 between them is empty
+	for i, epoch := range []uint64{1, 1, 3} {
+		blockchain.WritePlasmaBlockMeta(blocks[i].Hash(), blocks[i].NumberU64(), &rawdb.PlasmaBlockMeta{EpochNumber: epoch})
+	}
+	rcm := &RootChainManager{blockchain: blockchain}
+
+	tests := []struct {
+		fork, epoch, start, end int64
+		empty                   bool
+		submitted               bool
+	}{
+		{0, 1, 1, 2, false, true},
+		{0, 2, 3, 2, true, true},
+		{0, 3, 3, 4, false, false},
+		{0, 4, 5, 6, false, false},
+		{1, 1, 1, 2, false, false},
+	}
+	for i, tt := range tests {
+		e := &rootchain.RootChainEpochPrepared{
+			ForkNumber:       big.NewInt(tt.fork),
+			EpochNumber:      big.NewInt(tt.epoch),
+			StartBlockNumber: big.NewInt(tt.start),
+			EndBlockNumber:   big.NewInt(tt.end),
+			IsRequest:        tt.empty,
+			EpochIsEmpty:     tt.empty,
+		}
+		if submitted := rcm.epochSubmitted(e); submitted != tt.submitted {
+			t.Errorf("test %d: submitted mismatch: have %v, want %v", i, submitted, tt.submitted)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package pls
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/core"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+)
+
+// RootChainGenesisError is returned when the local genesis block doesn't match
+// the genesis block recorded by the RootChain contract.
+type RootChainGenesisError struct {
+	Contract common.Address
+	Diffs    []string
+}
+
+func (e *RootChainGenesisError) Error() string {
+	return fmt.Sprintf("genesis block doesn't match RootChain contract %s:\n%s", e.Contract.Hex(), strings.Join(e.Diffs, "\n"))
+}
+
+// genesisDiffs compares the genesis block recorded by the RootChain contract
+// with the local genesis header.
+func genesisDiffs(pb *PlasmaBlock, header *types.Header) []string {
+	var diffs []string
+	compare := func(name string, contract, local common.Hash) {
+		if contract != local {
+			diffs = append(diffs, fmt.Sprintf("  %-16s contract %s, local %s", name, contract.Hex(), local.Hex()))
+		}
+	}
+	compare("statesRoot", pb.StatesRoot, header.Root)
+	compare("transactionsRoot", pb.TransactionsRoot, header.TxHash)
+	compare("receiptsRoot", pb.ReceiptsRoot, header.ReceiptHash)
+	return diffs
+}
+
+// rootchainGenesisBlock returns the genesis block recorded in the first fork
+// of the RootChain contract.
+func rootchainGenesisBlock(contract *rootchain.RootChain) (*PlasmaBlock, error) {
+	b, err := contract.GetBlock(baseCallOpt, big.NewInt(0), big.NewInt(0))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read genesis block from RootChain contract: %v", err)
+	}
+	return newPlasmaBlock(b), nil
+}
+
+// VerifyRootChainGenesis checks that the genesis header matches the states,
+// transactions and receipts root given to the RootChain contract on deployment.
+func VerifyRootChainGenesis(contract *rootchain.RootChain, address common.Address, header *types.Header) error {
+	pb, err := rootchainGenesisBlock(contract)
+	if err != nil {
+		return err
+	}
+	if diffs := genesisDiffs(pb, header); len(diffs) != 0 {
+		return &RootChainGenesisError{Contract: address, Diffs: diffs}
+	}
+	return nil
+}
+
+// RootChainGenesis checks the genesis specification against the genesis block
+// of the plasma chain managed by the RootChain contract and returns it. If no
+// genesis is given, the default plasma genesis is checked, as nodes started
+// without an initialized genesis load it.
+func RootChainGenesis(contract *rootchain.RootChain, address common.Address, genesis *core.Genesis) (*core.Genesis, error) {
+	if genesis == nil {
+		genesis = core.DefaultGenesisBlock()
+	}
+	if err := VerifyRootChainGenesis(contract, address, genesis.ToBlock(nil).Header()); err != nil {
+		return nil, err
+	}
+	return genesis, nil
+}
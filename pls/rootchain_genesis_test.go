@@ -0,0 +1,32 @@
+package pls
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/core"
+)
+
+func TestGenesisDiffs(t *testing.T) {
+	header := core.DefaultGenesisBlock().ToBlock(nil).Header()
+
+	pb := &PlasmaBlock{
+		StatesRoot:       header.Root,
+		TransactionsRoot: header.TxHash,
+		ReceiptsRoot:     header.ReceiptHash,
+	}
+	if diffs := genesisDiffs(pb, header); len(diffs) != 0 {
+		t.Fatalf("matching genesis reported as different: %v", diffs)
+	}
+
+	pb.StatesRoot = common.HexToHash("0xdead")
+	diffs := genesisDiffs(pb, header)
+	if len(diffs) != 1 {
+		t.Fatalf("diff count mismatch: have %d, want 1", len(diffs))
+	}
+	err := &RootChainGenesisError{Diffs: diffs}
+	if want := header.Root.Hex(); !strings.Contains(err.Error(), want) {
+		t.Errorf("error %q doesn't contain local states root %s", err, want)
+	}
+}
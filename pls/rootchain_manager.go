@@ -15,6 +15,7 @@ import (
 	"github.com/Onther-Tech/plasma-evm/common"
 	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
 	"github.com/Onther-Tech/plasma-evm/core"
+	"github.com/Onther-Tech/plasma-evm/core/rawdb"
 	"github.com/Onther-Tech/plasma-evm/core/types"
 	"github.com/Onther-Tech/plasma-evm/ethclient"
 	"github.com/Onther-Tech/plasma-evm/event"
@@ -40,6 +41,7 @@ type invalidExit struct {
 	receipt     *types.Receipt
 	index       int64
 	proof       []common.Hash
+	challengeTx common.Hash
 }
 
 type invalidExits []*invalidExit
@@ -61,18 +63,28 @@ type RootChainManager struct {
 	minerEnv *miner.EpochEnvironment
 	state    *rootchainState
 
+	withholding *withholdingWatcher
+	submissions *submissionStats
+	statusCache statusCache
+	events      *rootchainEventIndex // Index of the RootChain contract events
+
 	// fork => block number => invalidExits
-	invalidExits map[uint64]map[uint64]invalidExits
+	invalidExits     map[uint64]map[uint64]invalidExits
+	invalidExitStats invalidExitStats
 
 	// channels
 	quit             chan struct{}
 	epochPreparedCh  chan *rootchain.RootChainEpochPrepared
 	blockFinalizedCh chan *rootchain.RootChainBlockFinalized
+	forkedCh         chan *rootchain.RootChainForked
 
 	lock sync.RWMutex // Protects the variadic fields (e.g. gas price and etherbase)
 }
 
 func (rcm *RootChainManager) RootchainContract() *rootchain.RootChain { return rcm.rootchainContract }
+func (rcm *RootChainManager) WithholdingStatus() *WithholdingStatus {
+	return rcm.withholding.status()
+}
 func (rcm *RootChainManager) NRELength() (*big.Int, error) {
 	return rcm.rootchainContract.NRELength(baseCallOpt)
 }
@@ -88,6 +100,7 @@ func NewRootChainManager(
 	accountManager *accounts.Manager,
 	miner *miner.Miner,
 	env *miner.EpochEnvironment,
+	events *rootchainEventIndex,
 ) (*RootChainManager, error) {
 	rcm := &RootChainManager{
 		config:            config,
@@ -100,13 +113,20 @@ func NewRootChainManager(
 		accountManager:    accountManager,
 		miner:             miner,
 		minerEnv:          env,
+		events:            events,
 		invalidExits:      make(map[uint64]map[uint64]invalidExits),
+		submissions:       new(submissionStats),
 		quit:              make(chan struct{}),
 		epochPreparedCh:   make(chan *rootchain.RootChainEpochPrepared, MAX_EPOCH_EVENTS),
 		blockFinalizedCh:  make(chan *rootchain.RootChainBlockFinalized),
+		forkedCh:          make(chan *rootchain.RootChainForked),
 	}
 
 	rcm.state = newRootchainState(rcm)
+	if rcm.state.requestGas > 0 {
+		txPool.SetRequestGas(rcm.state.requestGas)
+	}
+	rcm.withholding = newWithholdingWatcher(rcm, config.WithholdingTimeout)
 
 	epochLength, err := rcm.NRELength()
 	if err != nil {
@@ -139,6 +159,10 @@ func (rcm *RootChainManager) run() error {
 	go rcm.runSubmitter()
 	go rcm.runDetector()
 
+	if rcm.config.WithholdingTimeout > 0 {
+		go rcm.withholding.run()
+	}
+
 	if err := rcm.watchEvents(); err != nil {
 		return err
 	}
@@ -146,94 +170,71 @@ func (rcm *RootChainManager) run() error {
 	return nil
 }
 
-// watchEvents watchs RootChain contract events
+// watchEvents watchs RootChain contract events. EpochPrepared and BlockFinalized
+// events are read from the local event index, the past ones are handled first.
 func (rcm *RootChainManager) watchEvents() error {
 	filterer, err := rootchain.NewRootChainFilterer(rcm.config.RootChainContract, rcm.backend)
 	if err != nil {
 		return err
 	}
 
-	// rootchain block#1
-	startBlockNumber := uint64(1)
-
-	filterOpts := &bind.FilterOpts{
-		Start:   startBlockNumber,
-		End:     nil,
-		Context: context.Background(),
-	}
-
-	// iterate previous events
-	// TODO: the events fired while syncing should be dealt with in different way.
-	iterator, err := filterer.FilterEpochPrepared(filterOpts)
+	indexedCh := make(chan interface{})
+	past, indexedSub, err := rcm.events.watch(indexedCh, "EpochPrepared", "BlockFinalized")
 	if err != nil {
 		return err
 	}
 
-	log.Info("Iterating EpochPrepared event")
+	// iterate previous events
+	// TODO: the events fired while syncing should be dealt with in different way.
+	log.Info("Iterating indexed EpochPrepared and BlockFinalized events", "count", len(past))
 
-	for iterator.Next() {
-		e := iterator.Event
-		if e != nil {
+	for _, e := range past {
+		switch e := e.(type) {
+		case *rootchain.RootChainEpochPrepared:
 			rcm.handleEpochPrepared(e)
-		}
-	}
-
-	iterator2, err := filterer.FilterBlockFinalized(filterOpts)
-	if err != nil {
-		return err
-	}
-
-	log.Info("Iterating BlockFinalized event")
-
-	for iterator2.Next() {
-		e := iterator2.Event
-		if e != nil {
+		case *rootchain.RootChainBlockFinalized:
 			rcm.handleBlockFinalzied(e)
 		}
 	}
 
-	// watch events from now
-	watchOpts := &bind.WatchOpts{
-		Context: context.Background(),
-		Start:   &startBlockNumber, // read events from rootchain block#1
-	}
+	log.Info("Watching indexed EpochPrepared and BlockFinalized events")
 
-	epochPrepareWatchCh := make(chan *rootchain.RootChainEpochPrepared)
-	epochPrepareSub, err := filterer.WatchEpochPrepared(watchOpts, epochPrepareWatchCh)
+	// forks before the current fork are already applied, watch new forks only
+	forkedWatchCh := make(chan *rootchain.RootChainForked)
+	forkedSub, err := filterer.WatchForked(&bind.WatchOpts{Context: context.Background()}, forkedWatchCh)
 	if err != nil {
+		indexedSub.Unsubscribe()
 		return err
 	}
 
-	log.Info("Watching EpochPrepared event", "startBlockNumber", startBlockNumber)
-
-	blockFinalizedWatchCh := make(chan *rootchain.RootChainBlockFinalized)
-	blockFinalizedSub, err := filterer.WatchBlockFinalized(watchOpts, blockFinalizedWatchCh)
-	if err != nil {
-		return err
-	}
-
-	log.Info("watching BlockFinalized event", "startBlockNumber", startBlockNumber)
+	log.Info("Watching Forked event")
 
 	go func() {
+		defer indexedSub.Unsubscribe()
+		defer forkedSub.Unsubscribe()
+
 		for {
 			select {
-			case e := <-epochPrepareWatchCh:
-				if e != nil {
+			case e := <-indexedCh:
+				switch e := e.(type) {
+				case *rootchain.RootChainEpochPrepared:
 					rcm.epochPreparedCh <- e
+				case *rootchain.RootChainBlockFinalized:
+					rcm.blockFinalizedCh <- e
 				}
 
-			case err := <-epochPrepareSub.Err():
-				log.Error("EpochPrepared event subscription error", "err", err)
+			case err := <-indexedSub.Err():
+				log.Error("Indexed rootchain event subscription error", "err", err)
 				rcm.stopFn()
 				return
 
-			case e := <-blockFinalizedWatchCh:
+			case e := <-forkedWatchCh:
 				if e != nil {
-					rcm.blockFinalizedCh <- e
+					rcm.forkedCh <- e
 				}
 
-			case err := <-blockFinalizedSub.Err():
-				log.Error("BlockFinalized event subscription error", "err", err)
+			case err := <-forkedSub.Err():
+				log.Error("Forked event subscription error", "err", err)
 				rcm.stopFn()
 				return
 
@@ -283,13 +284,13 @@ func (rcm *RootChainManager) runSubmitter() {
 			}
 
 			funcName := "submitNRB"
-			if rcm.minerEnv.IsRequest {
+			if rcm.minerEnv.Status().IsRequest {
 				funcName = "submitORB"
 			}
 
 			input, err := rootchainContractABI.Pack(
 				funcName,
-				big.NewInt(int64(rcm.state.currentFork)),
+				big.NewInt(int64(rcm.state.getCurrentFork())),
 				blockInfo.Block.Header().Root,
 				blockInfo.Block.Header().TxHash,
 				blockInfo.Block.Header().ReceiptHash,
@@ -308,7 +309,9 @@ func (rcm *RootChainManager) runSubmitter() {
 			err = rcm.backend.SendTransaction(context.Background(), signedTx)
 			if err != nil {
 				log.Error("Failed to send "+funcName, "err", err)
+				rootchainErrorMeter.Mark(1)
 			}
+			rcm.submissions.sending(blockInfo.Block.NumberU64(), funcName, signedTx.Hash())
 
 			// wait root chain block is mined
 			<-blockSubmitEvents
@@ -316,12 +319,20 @@ func (rcm *RootChainManager) runSubmitter() {
 			receipt, err := rcm.backend.TransactionReceipt(context.Background(), signedTx.Hash())
 			log.Debug("signed tx receipt", "receipt", receipt, "hash", signedTx.Hash().String())
 
+			minedAt := time.Unix(blockInfo.Block.Time().Int64(), 0)
 			if err != nil {
 				log.Error("Failed to send "+funcName, "err", err)
+				rootchainErrorMeter.Mark(1)
+				rcm.submissions.done(signedTx.Hash(), SubmissionFailed, 0, minedAt)
 			} else if receipt.Status == 0 {
 				log.Error(funcName+" is reverted", "hash", signedTx.Hash().Hex())
+				revertedBlockMeter.Mark(1)
+				rcm.submissions.done(signedTx.Hash(), SubmissionReverted, receipt.GasUsed, minedAt)
 			} else {
+				submittedBlockMeter.Mark(1)
+				rcm.submissions.done(signedTx.Hash(), SubmissionMined, receipt.GasUsed, minedAt)
 				log.Info("Block is submitted", "funcName", funcName, "blockNumber", blockInfo.Block.NumberU64(), "hash", signedTx.Hash().String())
+				rcm.storeBlockMeta(blockInfo.Block, signedTx.Hash())
 			}
 
 			rcm.state.incNonce()
@@ -343,6 +354,10 @@ func (rcm *RootChainManager) runHandlers() {
 			if err := rcm.handleBlockFinalzied(e); err != nil {
 				log.Error("Failed to handle block finazlied", "err", err)
 			}
+		case e := <-rcm.forkedCh:
+			if err := rcm.handleForked(e); err != nil {
+				log.Error("Failed to handle fork", "err", err)
+			}
 		case <-rcm.quit:
 			return
 		}
@@ -357,7 +372,20 @@ func (rcm *RootChainManager) handleEpochPrepared(ev *rootchain.RootChainEpochPre
 
 	e := *ev
 
+	// the epoch may be prepared before the Forked event is delivered
+	if err := rcm.applyFork(e.ForkNumber.Uint64()); err != nil {
+		return err
+	}
+
+	// skip the epochs already submitted by this node, e.g. before a restart or
+	// a chain import, so that the operator resumes with the next epoch
+	if rcm.epochSubmitted(&e) {
+		log.Info("RootChain epoch already submitted", "epochNumber", e.EpochNumber, "isRequest", e.IsRequest, "isEmpty", e.EpochIsEmpty)
+		return nil
+	}
+
 	log.Info("RootChain epoch prepared", "epochNumber", e.EpochNumber, "isRequest", e.IsRequest, "userActivated", e.UserActivated, "isEmpty", e.EpochIsEmpty)
+	epochHandledMeter.Mark(1)
 	go rcm.eventMux.Post(miner.EpochPrepared{Payload: &e})
 	// prepare request tx for ORBs
 	if e.IsRequest && !e.EpochIsEmpty {
@@ -368,8 +396,9 @@ func (rcm *RootChainManager) handleEpochPrepared(ev *rootchain.RootChainEpochPre
 		numORBs = new(big.Int).Add(numORBs, big.NewInt(1))
 
 		bodies := make([]types.Transactions, 0, numORBs.Uint64()) // [][]types.Transaction
+		fetchStart := time.Now()
 
-		currentFork := big.NewInt(int64(rcm.state.currentFork))
+		currentFork := big.NewInt(int64(rcm.state.getCurrentFork()))
 		epoch, err := rcm.getEpoch(currentFork, e.EpochNumber)
 		if err != nil {
 			return err
@@ -384,6 +413,7 @@ func (rcm *RootChainManager) handleEpochPrepared(ev *rootchain.RootChainEpochPre
 
 			orb, err := rcm.rootchainContract.ORBs(baseCallOpt, requestBlockId)
 			if err != nil {
+				rootchainErrorMeter.Mark(1)
 				return err
 			}
 
@@ -393,35 +423,19 @@ func (rcm *RootChainManager) handleEpochPrepared(ev *rootchain.RootChainEpochPre
 			body := make(types.Transactions, 0, numRequests)
 
 			for requestId := orb.RequestStart; requestId <= orb.RequestEnd; {
-				request, err := rcm.rootchainContract.EROs(baseCallOpt, big.NewInt(int64(requestId)))
+				request, err := rcm.getRequest(requestId, false)
 				if err != nil {
+					rootchainErrorMeter.Mark(1)
 					return err
 				}
 
-				log.Debug("Request fetched", "requestId", requestId, "hash", common.Bytes2Hex(request.Hash[:]), "request", request)
-
-				var to common.Address
-				var input []byte
-
-				if request.IsTransfer {
-					to = request.Requestor
-				} else {
-					to, _ = rcm.rootchainContract.RequestableContracts(baseCallOpt, request.To)
-					input, err = requestableContractABI.Pack("applyRequestInChildChain",
-						request.IsExit,
-						big.NewInt(int64(requestId)),
-						request.Requestor,
-						request.TrieKey,
-						request.TrieValue,
-					)
-					if err != nil {
-						log.Error("Failed to pack applyRequestInChildChain", "err", err)
-					}
-
-					log.Debug("Request tx.data", "payload", input)
-				}
+				log.Debug("Request fetched", "requestId", requestId, "request", request)
 
-				requestTx := types.NewTransaction(0, to, request.Value, params.RequestTxGasLimit, params.RequestTxGasPrice, input)
+				requestTx, err := rcm.requestTx(requestId, request)
+				if err != nil {
+					rootchainErrorMeter.Mark(1)
+					return err
+				}
 
 				log.Debug("Request Transaction", "tx", requestTx)
 
@@ -448,11 +462,19 @@ func (rcm *RootChainManager) handleEpochPrepared(ev *rootchain.RootChainEpochPre
 			requestBlockId = new(big.Int).Add(requestBlockId, big.NewInt(1))
 		}
 
+		requestFetchTimer.UpdateSince(fetchStart)
+
+		// the miner takes the request blocks from the tx pool in order
+		for i, body := range bodies {
+			id, number := epoch.FirstRequestBlockId+uint64(i), e.StartBlockNumber.Uint64()+uint64(i)
+			if err := rcm.txPool.AddRequests(id, number, body); err != nil {
+				log.Error("Failed to add request transactions", "err", err)
+			}
+		}
+
 		var numMinedORBs uint64 = 0
 
 		for numMinedORBs < numORBs.Uint64() {
-			rcm.txPool.EnqueueReqeustTxs(bodies[numMinedORBs])
-
 			log.Info("Waiting new request block mined event...")
 
 			e := <-events.Chan()
@@ -468,7 +490,7 @@ func (rcm *RootChainManager) handleEpochPrepared(ev *rootchain.RootChainEpochPre
 
 			for _, receipt := range receipts {
 				if receipt.Status == 0 {
-					log.Error("Request transaction is reverted", "blockNumber", block.Number(), "hash", receipt.TxHash)
+					log.Error("Request transaction is reverted", "blockNumber", block.Number(), "hash", receipt.TxHash, "reason", receipt.RevertReason)
 				}
 			}
 
@@ -528,10 +550,14 @@ func (rcm *RootChainManager) handleBlockFinalzied(ev *rootchain.RootChainBlockFi
 			err = rcm.backend.SendTransaction(context.Background(), signedTx)
 			if err != nil {
 				log.Error("Failed to send challengeTx", "err", err)
+				rootchainErrorMeter.Mark(1)
 			} else {
+				challengeSentMeter.Mark(1)
 				log.Info("challengeExit is submitted", "exit request number", invalidExits[i].index, "hash", signedTx.Hash().Hex())
+				invalidExits[i].challengeTx = signedTx.Hash()
 			}
 		}
+		rcm.invalidExitStats.update(rcm.invalidExits)
 	}
 
 	return nil
@@ -557,9 +583,7 @@ func (rcm *RootChainManager) runDetector() {
 		select {
 		case ev := <-events.Chan():
 			rcm.lock.Lock()
-			if rcm.minerEnv.IsRequest {
-				var invalidExitsList invalidExits
-
+			if status := rcm.minerEnv.Status(); status.IsRequest {
 				forkNumber, err := caller.CurrentFork(callerOpts)
 				if err != nil {
 					log.Warn("failed to get current fork number", "error", err)
@@ -569,26 +593,13 @@ func (rcm *RootChainManager) runDetector() {
 					rcm.invalidExits[forkNumber.Uint64()] = make(map[uint64]invalidExits)
 				}
 
-				blockInfo := ev.Data.(core.NewMinedBlockEvent)
-				blockNumber := blockInfo.Block.Number()
-				receipts := rcm.blockchain.GetReceiptsByHash(blockInfo.Block.Hash())
-
-				// TODO: should check if the request[i] is enter or exit request. Undo request will make posterior enter request.
-				for i := 0; i < len(receipts); i++ {
-					if receipts[i].Status == types.ReceiptStatusFailed {
-						invalidExit := &invalidExit{
-							forkNumber:  forkNumber,
-							blockNumber: blockNumber,
-							receipt:     receipts[i],
-							index:       int64(i),
-							proof:       types.GetMerkleProof(receipts, i),
-						}
-						invalidExitsList = append(invalidExitsList, invalidExit)
-
-						log.Info("Invalid Exit Detected", "invalidExit", invalidExit, "forkNumber", forkNumber, "blockNumber", blockNumber)
-					}
+				block := ev.Data.(core.NewMinedBlockEvent).Block
+				invalidExitsList, err := rcm.detectInvalidExits(forkNumber, new(big.Int).SetUint64(uint64(status.EpochNumber)), block, status.UserActivated)
+				if err != nil {
+					log.Error("Failed to detect invalid exits", "number", block.Number(), "err", err)
 				}
-				rcm.invalidExits[forkNumber.Uint64()][blockNumber.Uint64()] = invalidExitsList
+				rcm.invalidExits[forkNumber.Uint64()][block.NumberU64()] = invalidExitsList
+				rcm.invalidExitStats.update(rcm.invalidExits)
 			}
 			rcm.lock.Unlock()
 
@@ -598,10 +609,54 @@ func (rcm *RootChainManager) runDetector() {
 	}
 }
 
+// detectInvalidExits lists the failed exits of a mined request block. Exits
+// and enters are told apart by the isExit flag of the requests in the RootChain
+// contract, as transfer enters carry no input.
+func (rcm *RootChainManager) detectInvalidExits(forkNumber, epochNumber *big.Int, block *types.Block, userActivated bool) (invalidExits, error) {
+	var (
+		list         invalidExits
+		requestStart *uint64
+	)
+	receipts := rcm.blockchain.GetReceiptsByHash(block.Hash())
+	for i := 0; i < len(receipts); i++ {
+		if receipts[i].Status != types.ReceiptStatusFailed {
+			continue
+		}
+		// Resolve the requests of the block once a failed request is found
+		if requestStart == nil {
+			start, err := rcm.minedRequestStart(forkNumber, epochNumber, block.NumberU64(), userActivated)
+			if err != nil {
+				return list, err
+			}
+			requestStart = &start
+		}
+		req, err := rcm.getRequest(*requestStart+uint64(i), userActivated)
+		if err != nil {
+			return list, err
+		}
+		// only failed exits can be challenged
+		if !req.IsExit {
+			continue
+		}
+		invalidExit := &invalidExit{
+			forkNumber:  forkNumber,
+			blockNumber: block.Number(),
+			receipt:     receipts[i],
+			index:       int64(i),
+			proof:       types.GetMerkleProof(receipts, i),
+		}
+		list = append(list, invalidExit)
+
+		log.Info("Invalid Exit Detected", "invalidExit", invalidExit, "forkNumber", forkNumber, "blockNumber", block.Number(), "reason", receipts[i].RevertReason)
+	}
+	return list, nil
+}
+
 func (rcm *RootChainManager) getEpoch(forkNumber, epochNumber *big.Int) (*PlasmaEpoch, error) {
 	b, err := rcm.rootchainContract.GetEpoch(baseCallOpt, forkNumber, epochNumber)
 
 	if err != nil {
+		rootchainErrorMeter.Mark(1)
 		return nil, err
 	}
 
@@ -611,6 +666,7 @@ func (rcm *RootChainManager) getBlock(forkNumber, blockNumber *big.Int) (*Plasma
 	b, err := rcm.rootchainContract.GetBlock(baseCallOpt, forkNumber, blockNumber)
 
 	if err != nil {
+		rootchainErrorMeter.Mark(1)
 		return nil, err
 	}
 
@@ -626,6 +682,7 @@ func (rcm *RootChainManager) pingBackend() {
 		case <-ticker.C:
 			if _, err := rcm.backend.SyncProgress(context.Background()); err != nil {
 				log.Error("Rootchain provider doesn't respond", "err", err)
+				rootchainErrorMeter.Mark(1)
 				ticker.Stop()
 				rcm.stopFn()
 				return
@@ -636,3 +693,36 @@ func (rcm *RootChainManager) pingBackend() {
 		}
 	}
 }
+
+// epochSubmitted reports whether the blocks of the epoch are submitted according
+// to the plasma metadata of the local chain. The last block of an epoch is
+// checked, or the first block after an empty epoch.
+func (rcm *RootChainManager) epochSubmitted(e *rootchain.RootChainEpochPrepared) bool {
+	number := e.EndBlockNumber.Uint64()
+	if e.EpochIsEmpty {
+		number = e.StartBlockNumber.Uint64()
+	}
+	block := rcm.blockchain.GetBlockByNumber(number)
+	if block == nil {
+		return false
+	}
+	meta := rcm.blockchain.GetPlasmaBlockMeta(block.Hash(), number)
+	return meta != nil && meta.ForkNumber == e.ForkNumber.Uint64() && meta.EpochNumber >= e.EpochNumber.Uint64()
+}
+
+// storeBlockMeta records the position of a submitted block in the RootChain
+// contract, so that it survives chain export and import.
+func (rcm *RootChainManager) storeBlockMeta(block *types.Block, submissionTx common.Hash) {
+	fork := rcm.state.getCurrentFork()
+	b, err := rcm.rootchainContract.GetBlock(baseCallOpt, new(big.Int).SetUint64(fork), block.Number())
+	if err != nil {
+		log.Error("Failed to get submitted block", "fork", fork, "number", block.NumberU64(), "err", err)
+		return
+	}
+	rcm.blockchain.WritePlasmaBlockMeta(block.Hash(), block.NumberU64(), &rawdb.PlasmaBlockMeta{
+		ForkNumber:     fork,
+		EpochNumber:    b.EpochNumber,
+		RequestBlockId: b.RequestBlockId,
+		SubmissionTx:   submissionTx,
+	})
+}
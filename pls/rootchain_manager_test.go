@@ -1300,6 +1300,9 @@ func makePls() (*Plasma, *rpc.Server, string, error) {
 		return nil, nil, d, err
 	}
 
+	pls.rootchainEvents = newRootchainEventIndex(db, rootchainBackend, config.RootChainContract)
+	pls.rootchainEvents.Start()
+
 	stopFn := func() { pls.Stop() }
 
 	if pls.rootchainManager, err = NewRootChainManager(
@@ -1313,6 +1316,7 @@ func makePls() (*Plasma, *rpc.Server, string, error) {
 		pls.accountManager,
 		pls.miner,
 		epochEnv,
+		pls.rootchainEvents,
 	); err != nil {
 		return nil, nil, d, err
 	}
@@ -1385,11 +1389,15 @@ func makeManager() (*RootChainManager, func(), error) {
 
 	var rcm *RootChainManager
 
+	events := newRootchainEventIndex(db, ethClient, contractAddress)
+	events.Start()
+
 	stopFn := func() {
 		blockchain.Stop()
 		txPool.Stop()
 		miner.Stop()
 		mux.Stop()
+		events.Stop()
 		rcm.Stop()
 	}
 	rcm, err = NewRootChainManager(
@@ -1403,6 +1411,7 @@ func makeManager() (*RootChainManager, func(), error) {
 		nil,
 		miner,
 		epochEnv,
+		events,
 	)
 
 	if err != nil {
@@ -1513,7 +1522,7 @@ func checkBlock(pls *Plasma, pbMinedEvents *event.TypeMuxSubscription, pbSubmite
 			return
 		}
 
-		pb, _ := pls.rootchainManager.getBlock(big.NewInt(int64(pls.rootchainManager.state.currentFork)), block.Number())
+		pb, _ := pls.rootchainManager.getBlock(big.NewInt(int64(pls.rootchainManager.state.getCurrentFork())), block.Number())
 
 		if pb.Timestamp == 0 {
 			log.Debug("Submitted plasma block", "pb", pb)
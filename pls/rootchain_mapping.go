@@ -0,0 +1,179 @@
+package pls
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	ethereum "github.com/Onther-Tech/plasma-evm"
+	"github.com/Onther-Tech/plasma-evm/accounts/abi/bind"
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/core"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/core/vm"
+	"github.com/Onther-Tech/plasma-evm/log"
+	"github.com/Onther-Tech/plasma-evm/params"
+)
+
+// RequestableContract is a requestable contract on the rootchain mapped to its
+// counterpart on the plasma chain.
+type RequestableContract struct {
+	RootChain  common.Address `json:"rootchain"`
+	ChildChain common.Address `json:"childchain"`
+}
+
+// checkRequestableContract makes sure the child contract implements
+// applyRequestInChildChain and dry-runs an empty enter request against it on the
+// current plasma state, the same way a request transaction applies it. Contracts
+// may only accept the trie keys and requestors they know, so the dry run is only
+// rejected if it fails in a way no request could pass, e.g. by running out of gas.
+func (rcm *RootChainManager) checkRequestableContract(child common.Address) error {
+	block := rcm.blockchain.CurrentBlock()
+	statedb, err := rcm.blockchain.StateAt(block.Root())
+	if err != nil {
+		return err
+	}
+	code := statedb.GetCode(child)
+	if len(code) == 0 {
+		return fmt.Errorf("no contract code at %s on the plasma chain", child.Hex())
+	}
+	if !hasMethodId(code, requestableContractABI.Methods["applyRequestInChildChain"].Id()) {
+		return errors.New("applyRequestInChildChain is not implemented")
+	}
+	input, err := requestableContractABI.Pack("applyRequestInChildChain", false, big.NewInt(0), rcm.config.Operator.Address, common.Hash{}, common.Hash{})
+	if err != nil {
+		return err
+	}
+	msg := types.NewMessage(params.NullAddress, &child, 0, big.NewInt(0), params.RequestTxGasLimit, big.NewInt(0), input, false)
+	context := core.NewEVMContext(msg, block.Header(), rcm.blockchain, nil)
+	evm := vm.NewEVM(context, statedb, rcm.blockchain.Config(), vm.Config{})
+
+	// Reverted calls return the gas left, any other failure consumes all of it
+	output, gas, err := evm.Call(vm.AccountRef(msg.From()), child, input, msg.Gas(), big.NewInt(0))
+	switch {
+	case err != nil && gas > 0:
+		reason, _ := core.UnpackRevertReason(output)
+		log.Debug("Requestable contract rejects the empty request", "contract", child, "reason", reason)
+	case err != nil:
+		return fmt.Errorf("applyRequestInChildChain failed: %v", err)
+	}
+	return nil
+}
+
+// hasMethodId reports whether the code pushes the method id onto the stack, as
+// the function dispatchers generated by the Solidity compiler do.
+func hasMethodId(code, id []byte) bool {
+	for pc := 0; pc < len(code); pc++ {
+		op := vm.OpCode(code[pc])
+		if op < vm.PUSH1 || op > vm.PUSH32 {
+			continue
+		}
+		size := int(op-vm.PUSH1) + 1
+		if size <= len(id) && pc+size < len(code) && bytes.Equal(common.LeftPadBytes(code[pc+1:pc+1+size], len(id)), id) {
+			return true
+		}
+		pc += size
+	}
+	return false
+}
+
+// mapRequestableContract maps the requestable contract root on the rootchain to
+// child on the plasma chain. The child contract and the mapping itself are
+// dry-run before the operator submits the mapping, which blocks until mined.
+func (rcm *RootChainManager) mapRequestableContract(ctx context.Context, root, child common.Address) (common.Hash, error) {
+	code, err := rcm.backend.CodeAt(ctx, root, nil)
+	if err != nil {
+		rootchainErrorMeter.Mark(1)
+		return common.Hash{}, err
+	}
+	if len(code) == 0 {
+		return common.Hash{}, fmt.Errorf("no contract code at %s on the rootchain", root.Hex())
+	}
+	mapped, err := rcm.rootchainContract.RequestableContracts(baseCallOpt, root)
+	if err != nil {
+		rootchainErrorMeter.Mark(1)
+		return common.Hash{}, err
+	}
+	if mapped != (common.Address{}) {
+		return common.Hash{}, fmt.Errorf("%s is already mapped to %s", root.Hex(), mapped.Hex())
+	}
+	if err := rcm.checkRequestableContract(child); err != nil {
+		return common.Hash{}, fmt.Errorf("%s is not requestable: %v", child.Hex(), err)
+	}
+
+	input, err := rootchainContractABI.Pack("mapRequestableContractByOperator", root, child)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	msg := ethereum.CallMsg{From: rcm.config.Operator.Address, To: &rcm.config.RootChainContract, Data: input}
+	if _, err := rcm.backend.CallContract(ctx, msg, nil); err != nil {
+		return common.Hash{}, fmt.Errorf("RootChain rejects the mapping: %v", err)
+	}
+	w, err := rcm.accountManager.Find(rcm.config.Operator)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	rcm.lock.Lock()
+	nonce := rcm.state.getNonce()
+	tx := types.NewTransaction(nonce, rcm.config.RootChainContract, big.NewInt(0), params.SubmitBlockGasLimit, params.SubmitBlockGasPrice, input)
+
+	signedTx, err := w.SignTx(rcm.config.Operator, tx, rootchainNetworkId)
+	if err != nil {
+		rcm.lock.Unlock()
+		return common.Hash{}, err
+	}
+	if err := rcm.backend.SendTransaction(ctx, signedTx); err != nil {
+		rcm.lock.Unlock()
+		rootchainErrorMeter.Mark(1)
+		return common.Hash{}, err
+	}
+	rcm.state.incNonce()
+	rcm.lock.Unlock()
+
+	log.Info("Requestable contract mapping is submitted", "rootchain", root, "childchain", child, "hash", signedTx.Hash())
+
+	receipt, err := bind.WaitMined(ctx, rcm.backend, signedTx)
+	if err != nil {
+		return signedTx.Hash(), err
+	}
+	if receipt.Status == types.ReceiptStatusFailed {
+		return signedTx.Hash(), fmt.Errorf("mapping transaction %s is reverted", signedTx.Hash().Hex())
+	}
+	return signedTx.Hash(), nil
+}
+
+// requestableContractList returns the current mappings of the requestable
+// contracts used by requests so far and of the given rootchain contracts.
+func (rcm *RootChainManager) requestableContractList(roots []common.Address) ([]*RequestableContract, error) {
+	contracts, err := rcm.requestableContracts()
+	if err != nil {
+		rootchainErrorMeter.Mark(1)
+		return nil, err
+	}
+	for _, root := range roots {
+		if _, ok := contracts[root]; ok {
+			continue
+		}
+		child, err := rcm.rootchainContract.RequestableContracts(baseCallOpt, root)
+		if err != nil {
+			rootchainErrorMeter.Mark(1)
+			return nil, err
+		}
+		if child != (common.Address{}) {
+			contracts[root] = child
+		}
+	}
+
+	list := make([]*RequestableContract, 0, len(contracts))
+	for root, child := range contracts {
+		list = append(list, &RequestableContract{RootChain: root, ChildChain: child})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return bytes.Compare(list[i].RootChain[:], list[j].RootChain[:]) < 0
+	})
+	return list, nil
+}
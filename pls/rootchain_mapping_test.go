@@ -0,0 +1,134 @@
+package pls
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/consensus/ethash"
+	"github.com/Onther-Tech/plasma-evm/core"
+	"github.com/Onther-Tech/plasma-evm/core/vm"
+	"github.com/Onther-Tech/plasma-evm/ethdb"
+	"github.com/Onther-Tech/plasma-evm/params"
+)
+
+var (
+	mappingTestRoot  = common.HexToAddress("0x0100000000000000000000000000000000000000")
+	mappingTestChild = common.HexToAddress("0x0200000000000000000000000000000000000000")
+)
+
+// requestableTestCode returns runtime code dispatching applyRequestInChildChain
+// to body, which runs whatever the request is.
+func requestableTestCode(body string) []byte {
+	id := common.Bytes2Hex(requestableContractABI.Methods["applyRequestInChildChain"].Id())
+	return common.Hex2Bytes("63" + id + "50" + body) // PUSH4 id POP
+}
+
+var (
+	requestableTestAccept = "6001600052" + "60206000f3" // return true
+	requestableTestRevert = "60006000fd"                // revert, as if the trie key is unknown
+	requestableTestFail   = "fe"                        // invalid opcode, consuming all gas
+)
+
+// newMappingTestManager creates a RootChainManager whose plasma chain has the
+// given code deployed at mappingTestChild.
+func newMappingTestManager(t *testing.T, backend *testRootChainBackend, code []byte) *RootChainManager {
+	var (
+		db    = ethdb.NewMemDatabase()
+		gspec = &core.Genesis{Config: params.TestChainConfig, Alloc: core.GenesisAlloc{}}
+	)
+	if code != nil {
+		gspec.Alloc[mappingTestChild] = core.GenesisAccount{Code: code, Balance: big.NewInt(0)}
+	}
+	gspec.MustCommit(db)
+
+	blockchain, err := core.NewBlockChain(db, nil, gspec.Config, ethash.NewFaker(), vm.Config{}, nil)
+	if err != nil {
+		t.Fatalf("failed to create blockchain: %v", err)
+	}
+	rcm := backend.manager(&Config{RootChainContract: common.HexToAddress("0x0300000000000000000000000000000000000000")})
+	rcm.blockchain = blockchain
+	return rcm
+}
+
+// Tests that child contracts are accepted if they implement the request function,
+// even if they reject the empty request of the dry run.
+func TestCheckRequestableContract(t *testing.T) {
+	tests := []struct {
+		code []byte
+		err  string
+	}{
+		{requestableTestCode(requestableTestAccept), ""},
+		{requestableTestCode(requestableTestRevert), ""},
+		{requestableTestCode(requestableTestFail), "applyRequestInChildChain failed"},
+		{common.Hex2Bytes(requestableTestAccept), "not implemented"},
+		{nil, "no contract code"},
+	}
+	for i, tt := range tests {
+		rcm := newMappingTestManager(t, newTestRootChainBackend(rootchainContractABI), tt.code)
+		err := rcm.checkRequestableContract(mappingTestChild)
+		rcm.blockchain.Stop()
+
+		switch {
+		case tt.err == "" && err != nil:
+			t.Errorf("test %d: contract rejected: %v", i, err)
+		case tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)):
+			t.Errorf("test %d: error mismatch: have %v, want %q", i, err, tt.err)
+		}
+	}
+}
+
+// Tests that mappings are only submitted for unmapped rootchain contracts and
+// child contracts passing the dry run.
+func TestMapRequestableContract(t *testing.T) {
+	tests := []struct {
+		rootCode []byte
+		mapped   common.Address
+		body     string
+		err      string
+	}{
+		// no code on the rootchain
+		{nil, common.Address{}, requestableTestAccept, "no contract code"},
+		// already mapped
+		{[]byte{0x00}, common.HexToAddress("0x04"), requestableTestAccept, "already mapped"},
+		// dry run failure
+		{[]byte{0x00}, common.Address{}, requestableTestFail, "is not requestable"},
+		// dry run revert, the mapping is checked against the RootChain contract
+		{[]byte{0x00}, common.Address{}, requestableTestRevert, "RootChain rejects the mapping"},
+	}
+	for i, tt := range tests {
+		backend := newTestRootChainBackend(rootchainContractABI)
+		if tt.rootCode != nil {
+			backend.Code[mappingTestRoot] = tt.rootCode
+		}
+		mapped := tt.mapped
+		backend.Handle("requestableContracts", func(args []interface{}) ([]interface{}, error) {
+			return []interface{}{mapped}, nil
+		})
+		checked := false
+		backend.Handle("mapRequestableContractByOperator", func(args []interface{}) ([]interface{}, error) {
+			checked = true
+			if args[0].(common.Address) != mappingTestRoot || args[1].(common.Address) != mappingTestChild {
+				t.Errorf("test %d: mapping mismatch: have %x -> %x, want %x -> %x", i, args[0], args[1], mappingTestRoot, mappingTestChild)
+			}
+			return nil, errors.New("not the operator")
+		})
+		rcm := newMappingTestManager(t, backend, requestableTestCode(tt.body))
+
+		_, err := rcm.mapRequestableContract(context.Background(), mappingTestRoot, mappingTestChild)
+		rcm.blockchain.Stop()
+
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("test %d: error mismatch: have %v, want %q", i, err, tt.err)
+		}
+		if want := tt.err == "RootChain rejects the mapping"; checked != want {
+			t.Errorf("test %d: mapping check mismatch: have %v, want %v", i, checked, want)
+		}
+		if len(backend.Sent) != 0 {
+			t.Errorf("test %d: mapping transaction sent", i)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package pls
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+)
+
+// errNoRootChainPivot is returned if no block is finalized in the RootChain
+// contract yet, as the pivot can't be trusted from the peers.
+var errNoRootChainPivot = errors.New("no finalized block in the RootChain contract")
+
+// rootchainPivot is a downloader.PivotOracle picking the fast sync pivot from
+// the last finalized block in the RootChain contract, so that a follower doesn't
+// trust any peer for the state it syncs.
+type rootchainPivot struct {
+	contract *rootchain.RootChain
+}
+
+// Pivot returns the last finalized block of the current fork and the states root
+// recorded for it. If no block of the current fork is finalized yet, the previous
+// forks are searched. If no block is finalized at all, fast sync fails instead
+// of falling back to the pivot advertised by the peers.
+func (p *rootchainPivot) Pivot() (uint64, common.Hash, error) {
+	currentFork, err := p.contract.CurrentFork(baseCallOpt)
+	if err != nil {
+		return 0, common.Hash{}, err
+	}
+	for fork := new(big.Int).Set(currentFork); fork.Sign() >= 0; fork.Sub(fork, big.NewInt(1)) {
+		number, err := p.contract.GetLastFinalizedBlock(baseCallOpt, fork)
+		if err != nil {
+			return 0, common.Hash{}, err
+		}
+		if number.Sign() == 0 {
+			continue
+		}
+		b, err := p.contract.GetBlock(baseCallOpt, fork, number)
+		if err != nil {
+			return 0, common.Hash{}, err
+		}
+		pb := newPlasmaBlock(b)
+		if !pb.Finalized {
+			return 0, common.Hash{}, fmt.Errorf("last finalized block %d of fork %d is not finalized", number, fork)
+		}
+		return number.Uint64(), pb.StatesRoot, nil
+	}
+	return 0, common.Hash{}, errNoRootChainPivot
+}
@@ -0,0 +1,69 @@
+package pls
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+)
+
+// newTestPivot returns a rootchainPivot reading the last finalized blocks of
+// the forks from the map, and the blocks' finality from finalized.
+func newTestPivot(t *testing.T, currentFork int64, lastFinalized map[int64]int64, finalized bool) *rootchainPivot {
+	backend := newTestRootChainBackend(rootchainContractABI)
+	backend.Handle("currentFork", func([]interface{}) ([]interface{}, error) {
+		return []interface{}{big.NewInt(currentFork)}, nil
+	})
+	backend.Handle("getLastFinalizedBlock", func(args []interface{}) ([]interface{}, error) {
+		return []interface{}{big.NewInt(lastFinalized[args[0].(*big.Int).Int64()])}, nil
+	})
+	backend.Handle("getBlock", func(args []interface{}) ([]interface{}, error) {
+		root := common.BigToHash(args[0].(*big.Int)) // states root of the fork
+		return []interface{}{
+			uint64(0), uint64(0), uint64(0), uint64(0), // epoch, request block, reference block, timestamp
+			root, [32]byte{}, [32]byte{}, // states, transactions and receipts roots
+			false, false, false, false, finalized, // request, user activated, challenged, challenging, finalized
+		}, nil
+	})
+	contract, err := rootchain.NewRootChain(common.Address{}, backend.Client())
+	if err != nil {
+		t.Fatalf("failed to bind RootChain contract: %v", err)
+	}
+	return &rootchainPivot{contract}
+}
+
+func TestRootchainPivot(t *testing.T) {
+	tests := []struct {
+		currentFork   int64
+		lastFinalized map[int64]int64
+		finalized     bool
+
+		number uint64
+		root   common.Hash
+		err    error
+	}{
+		// last finalized block of the current fork
+		{2, map[int64]int64{0: 10, 1: 20, 2: 30}, true, 30, common.BigToHash(big.NewInt(2)), nil},
+		// nothing finalized in the current fork yet
+		{2, map[int64]int64{0: 10, 1: 20}, true, 20, common.BigToHash(big.NewInt(1)), nil},
+		{1, map[int64]int64{0: 10}, true, 10, common.Hash{}, nil},
+		// nothing finalized at all, the peers must not be trusted
+		{1, map[int64]int64{}, true, 0, common.Hash{}, errNoRootChainPivot},
+	}
+	for i, tt := range tests {
+		number, root, err := newTestPivot(t, tt.currentFork, tt.lastFinalized, tt.finalized).Pivot()
+		if err != tt.err {
+			t.Errorf("test %d: error mismatch: have %v, want %v", i, err, tt.err)
+			continue
+		}
+		if number != tt.number || root != tt.root {
+			t.Errorf("test %d: pivot mismatch: have %d %x, want %d %x", i, number, root, tt.number, tt.root)
+		}
+	}
+
+	// a last finalized block which isn't finalized is inconsistent
+	if _, _, err := newTestPivot(t, 0, map[int64]int64{0: 10}, false).Pivot(); err == nil {
+		t.Error("unfinalized pivot accepted")
+	}
+}
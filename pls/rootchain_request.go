@@ -0,0 +1,321 @@
+package pls
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/common/hexutil"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/params"
+)
+
+// maxFailedRequestBlocks is the maximum number of blocks scanned by a single
+// failed request query.
+const maxFailedRequestBlocks = 1024
+
+// FailedRequest is a request transaction reverted in a request block.
+type FailedRequest struct {
+	ForkNumber        hexutil.Uint64 `json:"forkNumber"`
+	BlockNumber       hexutil.Uint64 `json:"blockNumber"`
+	BlockHash         common.Hash    `json:"blockHash"`
+	TxHash            common.Hash    `json:"transactionHash"`
+	TxIndex           hexutil.Uint   `json:"transactionIndex"`
+	RequestId         hexutil.Uint64 `json:"requestId"`
+	UserActivated     bool           `json:"userActivated"`
+	IsExit            bool           `json:"isExit"`
+	IsTransfer        bool           `json:"isTransfer"`
+	Requestor         common.Address `json:"requestor"`
+	Contract          common.Address `json:"contract"`
+	RootChainContract common.Address `json:"rootchainContract"`
+	RevertReason      string         `json:"revertReason"`
+
+	// ExitChallengeCandidate is set for a failed exit which is neither
+	// challenged nor finalized yet.
+	ExitChallengeCandidate bool `json:"exitChallengeCandidate"`
+}
+
+// request is the part of an enter or exit request on the RootChain contract
+// needed to build or diagnose its request transaction.
+type request struct {
+	IsExit     bool
+	IsTransfer bool
+	Finalized  bool
+	Challenged bool
+	Value      *big.Int
+	Requestor  common.Address
+	To         common.Address
+	TrieKey    [32]byte
+	TrieValue  [32]byte
+}
+
+// minedRequestStart returns the first request ID of a request block mined in
+// the given epoch, which may not be submitted to the RootChain contract yet.
+func (rcm *RootChainManager) minedRequestStart(fork, epochNumber *big.Int, number uint64, userActivated bool) (uint64, error) {
+	epoch, err := rcm.getEpoch(fork, epochNumber)
+	if err != nil {
+		return 0, err
+	}
+	if !epoch.IsRequest || number < epoch.StartBlockNumber || number > epoch.EndBlockNumber {
+		return 0, fmt.Errorf("block %d is not in request epoch %d of fork %d", number, epochNumber, fork)
+	}
+	return rcm.requestBlockStart(epoch.FirstRequestBlockId+number-epoch.StartBlockNumber, userActivated)
+}
+
+// requestBlockStart returns the first request ID of the request block.
+func (rcm *RootChainManager) requestBlockStart(requestBlockId uint64, userActivated bool) (uint64, error) {
+	id := new(big.Int).SetUint64(requestBlockId)
+	if userActivated {
+		urb, err := rcm.rootchainContract.URBs(baseCallOpt, id)
+		return urb.RequestStart, err
+	}
+	orb, err := rcm.rootchainContract.ORBs(baseCallOpt, id)
+	return orb.RequestStart, err
+}
+
+// getRequest returns the enter or exit request with the given ID.
+func (rcm *RootChainManager) getRequest(requestId uint64, userActivated bool) (*request, error) {
+	id := new(big.Int).SetUint64(requestId)
+	if userActivated {
+		eru, err := rcm.rootchainContract.ERUs(baseCallOpt, id)
+		if err != nil {
+			return nil, err
+		}
+		return &request{eru.IsExit, eru.IsTransfer, eru.Finalized, eru.Challenged, eru.Value, eru.Requestor, eru.To, eru.TrieKey, eru.TrieValue}, nil
+	}
+	ero, err := rcm.rootchainContract.EROs(baseCallOpt, id)
+	if err != nil {
+		return nil, err
+	}
+	return &request{ero.IsExit, ero.IsTransfer, ero.Finalized, ero.Challenged, ero.Value, ero.Requestor, ero.To, ero.TrieKey, ero.TrieValue}, nil
+}
+
+// requestTx creates the request transaction applying the request with the
+// given ID in the child chain, as the RootChain contract encodes it. Transfers
+// send the value to the target of the request, other requests call the child
+// chain contract mapped to the target.
+func (rcm *RootChainManager) requestTx(requestId uint64, req *request) (*types.Transaction, error) {
+	if req.IsTransfer {
+		return types.NewTransaction(0, req.To, req.Value, params.RequestTxGasLimit, params.RequestTxGasPrice, nil), nil
+	}
+	to, err := rcm.rootchainContract.RequestableContracts(baseCallOpt, req.To)
+	if err != nil {
+		return nil, err
+	}
+	input, err := requestableContractABI.Pack("applyRequestInChildChain",
+		req.IsExit,
+		new(big.Int).SetUint64(requestId),
+		req.Requestor,
+		req.TrieKey,
+		req.TrieValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewTransaction(0, to, req.Value, params.RequestTxGasLimit, params.RequestTxGasPrice, input), nil
+}
+
+// failedRequests lists the reverted request transactions of the request blocks
+// between from and to, both inclusive, as submitted to the given fork.
+func (rcm *RootChainManager) failedRequests(fork, from, to uint64) ([]*FailedRequest, error) {
+	if from > to {
+		return nil, fmt.Errorf("invalid block range %d-%d", from, to)
+	}
+	if to-from >= maxFailedRequestBlocks {
+		return nil, fmt.Errorf("block range %d-%d exceeds %d blocks", from, to, maxFailedRequestBlocks)
+	}
+	forkNumber := new(big.Int).SetUint64(fork)
+
+	failed := []*FailedRequest{}
+	for number := from; number <= to; number++ {
+		block := rcm.blockchain.GetBlockByNumber(number)
+		if block == nil {
+			break
+		}
+		if !block.IsRequest() {
+			continue
+		}
+		receipts := rcm.blockchain.GetReceiptsByHash(block.Hash())
+
+		var pb *PlasmaBlock
+		var requestStart uint64
+		for i, receipt := range receipts {
+			if receipt.Status != types.ReceiptStatusFailed {
+				continue
+			}
+			// Resolve the request block once a failed request is found
+			if pb == nil {
+				var err error
+				if pb, err = rcm.getBlock(forkNumber, block.Number()); err != nil {
+					return nil, err
+				}
+				if !pb.IsRequest {
+					return nil, fmt.Errorf("block %d is not submitted as a request block to fork %d", number, fork)
+				}
+				if requestStart, err = rcm.requestBlockStart(pb.RequestBlockId, pb.UserActivated); err != nil {
+					return nil, err
+				}
+			}
+			requestId := requestStart + uint64(i)
+			req, err := rcm.getRequest(requestId, pb.UserActivated)
+			if err != nil {
+				return nil, err
+			}
+			tx := block.Transactions()[i]
+
+			fr := &FailedRequest{
+				ForkNumber:             hexutil.Uint64(fork),
+				BlockNumber:            hexutil.Uint64(number),
+				BlockHash:              block.Hash(),
+				TxHash:                 tx.Hash(),
+				TxIndex:                hexutil.Uint(i),
+				RequestId:              hexutil.Uint64(requestId),
+				UserActivated:          pb.UserActivated,
+				IsExit:                 req.IsExit,
+				IsTransfer:             req.IsTransfer,
+				Requestor:              req.Requestor,
+				RevertReason:           receipt.RevertReason,
+				ExitChallengeCandidate: req.IsExit && !req.Challenged && !req.Finalized,
+			}
+			if !req.IsTransfer {
+				fr.Contract = *tx.To()
+				fr.RootChainContract = req.To
+			}
+			failed = append(failed, fr)
+		}
+	}
+	return failed, nil
+}
+
+// findRequestTx locates the request transaction applying the enter or exit
+// request with the given ID in the local canonical chain. User activated
+// requests are ERUs applied by user request blocks, the others are EROs applied
+// by operator request blocks.
+func (rcm *RootChainManager) findRequestTx(requestId uint64, userActivated bool) (*types.Block, int, error) {
+	if userActivated {
+		return rcm.findUserRequestTx(requestId)
+	}
+	numEROs, err := rcm.rootchainContract.GetNumEROs(baseCallOpt)
+	if err != nil {
+		return nil, 0, err
+	}
+	if requestId >= numEROs.Uint64() {
+		return nil, 0, fmt.Errorf("request %d not found", requestId)
+	}
+	numORBs, err := rcm.rootchainContract.GetNumORBs(baseCallOpt)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Request blocks cover consecutive request ranges, search the including one
+	var searchErr error
+	requestBlockId := uint64(sort.Search(int(numORBs.Uint64()), func(i int) bool {
+		orb, err := rcm.rootchainContract.ORBs(baseCallOpt, big.NewInt(int64(i)))
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return orb.RequestEnd >= requestId
+	}))
+	if searchErr != nil {
+		return nil, 0, searchErr
+	}
+	if requestBlockId == numORBs.Uint64() {
+		return nil, 0, fmt.Errorf("request %d is not included in a request block yet", requestId)
+	}
+	orb, err := rcm.rootchainContract.ORBs(baseCallOpt, new(big.Int).SetUint64(requestBlockId))
+	if err != nil {
+		return nil, 0, err
+	}
+	if orb.RequestStart > requestId {
+		return nil, 0, fmt.Errorf("request %d is not included in a request block yet", requestId)
+	}
+
+	fork := new(big.Int).SetUint64(rcm.state.getCurrentFork())
+	epoch, err := rcm.getEpoch(fork, new(big.Int).SetUint64(orb.EpochNumber))
+	if err != nil {
+		return nil, 0, err
+	}
+	lastRequestBlockId := epoch.FirstRequestBlockId + epoch.EndBlockNumber - epoch.StartBlockNumber
+	if !epoch.IsRequest || requestBlockId < epoch.FirstRequestBlockId || requestBlockId > lastRequestBlockId {
+		return nil, 0, fmt.Errorf("request block %d is not in epoch %d of fork %d", requestBlockId, orb.EpochNumber, fork)
+	}
+
+	number := epoch.StartBlockNumber + requestBlockId - epoch.FirstRequestBlockId
+	return rcm.requestTxAt(number, requestId, requestId-orb.RequestStart)
+}
+
+// findUserRequestTx locates the request transaction applying the ERU with the
+// given ID in the local canonical chain. The RootChain contract doesn't count
+// ERUs and URBs, so the user request epoch including the request is looked up
+// in the event index, and its URBs are searched for the request.
+func (rcm *RootChainManager) findUserRequestTx(requestId uint64) (*types.Block, int, error) {
+	if _, err := rcm.getRequest(requestId, true); err != nil {
+		return nil, 0, fmt.Errorf("request %d not found: %v", requestId, err)
+	}
+	fork := rcm.state.getCurrentFork()
+
+	// Epochs are rebased on forks, the last event of the fork is the current one
+	events, err := rcm.events.decoded("EpochPrepared", "EpochRebased")
+	if err != nil {
+		return nil, 0, err
+	}
+	var epochNumber *big.Int
+	for _, event := range events {
+		var e rootchain.RootChainEpochPrepared
+		switch event := event.(type) {
+		case *rootchain.RootChainEpochPrepared:
+			e = *event
+		case *rootchain.RootChainEpochRebased:
+			e = rootchain.RootChainEpochPrepared{
+				ForkNumber:    event.ForkNumber,
+				EpochNumber:   event.EpochNumber,
+				RequestStart:  event.RequestStart,
+				RequestEnd:    event.RequestEnd,
+				EpochIsEmpty:  event.EpochIsEmpty,
+				IsRequest:     event.IsRequest,
+				UserActivated: event.UserActivated,
+			}
+		}
+		if e.ForkNumber.Uint64() == fork && e.UserActivated && e.IsRequest && !e.EpochIsEmpty &&
+			e.RequestStart.Uint64() <= requestId && requestId <= e.RequestEnd.Uint64() {
+			epochNumber = e.EpochNumber
+		}
+	}
+	if epochNumber == nil {
+		return nil, 0, fmt.Errorf("request %d is not included in a user request block of fork %d yet", requestId, fork)
+	}
+	epoch, err := rcm.getEpoch(new(big.Int).SetUint64(fork), epochNumber)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !epoch.IsRequest || !epoch.UserActivated {
+		return nil, 0, fmt.Errorf("epoch %d of fork %d is not a user request epoch", epochNumber, fork)
+	}
+	for number := epoch.StartBlockNumber; number <= epoch.EndBlockNumber; number++ {
+		requestBlockId := epoch.FirstRequestBlockId + number - epoch.StartBlockNumber
+		urb, err := rcm.rootchainContract.URBs(baseCallOpt, new(big.Int).SetUint64(requestBlockId))
+		if err != nil {
+			return nil, 0, err
+		}
+		if urb.RequestStart <= requestId && requestId <= urb.RequestEnd {
+			return rcm.requestTxAt(number, requestId, requestId-urb.RequestStart)
+		}
+	}
+	return nil, 0, fmt.Errorf("request %d not found in the user request blocks of epoch %d of fork %d", requestId, epochNumber, fork)
+}
+
+// requestTxAt returns the request block with the given number in the local
+// canonical chain, checking that it includes the request at the index.
+func (rcm *RootChainManager) requestTxAt(number, requestId, index uint64) (*types.Block, int, error) {
+	block := rcm.blockchain.GetBlockByNumber(number)
+	if block == nil {
+		return nil, 0, fmt.Errorf("request block #%d not found", number)
+	}
+	if !block.IsRequest() || index >= uint64(len(block.Transactions())) {
+		return nil, 0, fmt.Errorf("block #%d doesn't include request %d", number, requestId)
+	}
+	return block, int(index), nil
+}
@@ -0,0 +1,307 @@
+package pls
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/consensus/ethash"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/epochhandler"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/core"
+	"github.com/Onther-Tech/plasma-evm/core/rawdb"
+	"github.com/Onther-Tech/plasma-evm/core/state"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/core/vm"
+	"github.com/Onther-Tech/plasma-evm/core/vm/runtime"
+	"github.com/Onther-Tech/plasma-evm/ethdb"
+	"github.com/Onther-Tech/plasma-evm/params"
+)
+
+// Tests that only the failed exits of a request block are detected as invalid,
+// including when a transfer enter without input fails.
+func TestDetectInvalidExits(t *testing.T) {
+	var (
+		db      = ethdb.NewMemDatabase()
+		gspec   = &core.Genesis{Config: params.TestChainConfig}
+		genesis = gspec.MustCommit(db)
+	)
+	blockchain, err := core.NewBlockChain(db, nil, gspec.Config, ethash.NewFaker(), vm.Config{}, nil)
+	if err != nil {
+		t.Fatalf("failed to create blockchain: %v", err)
+	}
+	defer blockchain.Stop()
+
+	// Request block #4 applies a transfer enter, an exit, both failing, and a
+	// successful exit
+	txs := types.Transactions{
+		types.NewTransaction(0, common.HexToAddress("0x01"), big.NewInt(1), params.RequestTxGasLimit, params.RequestTxGasPrice, nil),
+		types.NewTransaction(0, common.HexToAddress("0x02"), big.NewInt(0), params.RequestTxGasLimit, params.RequestTxGasPrice, []byte{0x01}),
+		types.NewTransaction(0, common.HexToAddress("0x02"), big.NewInt(0), params.RequestTxGasLimit, params.RequestTxGasPrice, []byte{0x02}),
+	}
+	receipts := types.Receipts{
+		{Status: types.ReceiptStatusFailed, Logs: []*types.Log{}},
+		{Status: types.ReceiptStatusFailed, Logs: []*types.Log{}},
+		{Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{}},
+	}
+	block := types.NewBlock(&types.Header{Number: big.NewInt(4), ParentHash: genesis.Hash()}, txs, nil, receipts)
+	rawdb.WriteBlock(db, block)
+	rawdb.WriteReceipts(db, block.Hash(), block.NumberU64(), receipts)
+
+	backend := newTestRootChainBackend(rootchainContractABI)
+	backend.Handle("getEpoch", func([]interface{}) ([]interface{}, error) {
+		// request epoch of blocks #3-#4, starting with request block #2
+		return []interface{}{
+			uint64(0), uint64(0), uint64(3), uint64(4), uint64(2), uint64(0), // requests, blocks, first request block, enters
+			false, true, true, false, false, // empty, initialized, request, user activated, rebase
+		}, nil
+	})
+	backend.Handle("ORBs", func(args []interface{}) ([]interface{}, error) {
+		if id := args[0].(*big.Int).Uint64(); id != 3 {
+			t.Errorf("request block mismatch: have %d, want 3", id)
+		}
+		return []interface{}{true, uint64(0), uint64(1), uint64(10), uint64(12), common.Address{}}, nil
+	})
+	backend.Handle("EROs", func(args []interface{}) ([]interface{}, error) {
+		id := args[0].(*big.Int).Uint64()
+		isExit, isTransfer := id != 10, id == 10
+		return []interface{}{
+			uint64(0), isExit, isTransfer, false, false, big.NewInt(0),
+			common.Address{}, common.Address{}, [32]byte{}, [32]byte{}, [32]byte{},
+		}, nil
+	})
+	rcm := backend.manager(&Config{})
+	rcm.blockchain = blockchain
+
+	exits, err := rcm.detectInvalidExits(big.NewInt(0), big.NewInt(1), block, false)
+	if err != nil {
+		t.Fatalf("failed to detect invalid exits: %v", err)
+	}
+	if len(exits) != 1 {
+		t.Fatalf("invalid exit count mismatch: have %d, want 1", len(exits))
+	}
+	if exits[0].index != 1 || exits[0].blockNumber.Uint64() != 4 {
+		t.Errorf("invalid exit mismatch: have tx %d of block %d, want tx 1 of block 4", exits[0].index, exits[0].blockNumber)
+	}
+}
+
+// Tests that the invalid exits are read from their snapshot in order, without
+// waiting for the rootchain manager, which is locked during block submissions.
+func TestInvalidExitsSnapshot(t *testing.T) {
+	rcm := &RootChainManager{invalidExits: make(map[uint64]map[uint64]invalidExits)}
+
+	receipt := func(tx byte) *types.Receipt {
+		return &types.Receipt{TxHash: common.Hash{tx}, RevertReason: "invalid exit"}
+	}
+	rcm.lock.Lock()
+	rcm.invalidExits[1] = map[uint64]invalidExits{
+		7: {{index: 2, receipt: receipt(3)}, {index: 0, receipt: receipt(2)}},
+		5: {{index: 1, receipt: receipt(1), challengeTx: common.Hash{0xff}}},
+	}
+	rcm.invalidExitStats.update(rcm.invalidExits)
+
+	// Keep the manager locked, the way a pending block submission does
+	done := make(chan []*InvalidExit)
+	go func() { done <- rcm.InvalidExits() }()
+
+	var exits []*InvalidExit
+	select {
+	case exits = <-done:
+	case <-time.After(time.Second):
+		t.Fatal("invalid exits blocked on the rootchain manager lock")
+	}
+	rcm.lock.Unlock()
+
+	want := []InvalidExit{
+		{ForkNumber: 1, BlockNumber: 5, Index: 1, TxHash: common.Hash{1}, Reason: "invalid exit", ChallengeTx: common.Hash{0xff}},
+		{ForkNumber: 1, BlockNumber: 7, Index: 0, TxHash: common.Hash{2}, Reason: "invalid exit"},
+		{ForkNumber: 1, BlockNumber: 7, Index: 2, TxHash: common.Hash{3}, Reason: "invalid exit"},
+	}
+	if len(exits) != len(want) {
+		t.Fatalf("invalid exit count mismatch: have %d, want %d", len(exits), len(want))
+	}
+	for i := range want {
+		if *exits[i] != want[i] {
+			t.Errorf("invalid exit %d mismatch: have %+v, want %+v", i, *exits[i], want[i])
+		}
+	}
+}
+
+// Tests that ERUs are located in the user request blocks of the indexed user
+// request epoch including them.
+func TestFindUserRequestTx(t *testing.T) {
+	var (
+		db      = ethdb.NewMemDatabase()
+		gspec   = &core.Genesis{Config: params.TestChainConfig}
+		genesis = gspec.MustCommit(db)
+	)
+	blockchain, err := core.NewBlockChain(db, nil, gspec.Config, ethash.NewFaker(), vm.Config{}, nil)
+	if err != nil {
+		t.Fatalf("failed to create blockchain: %v", err)
+	}
+	defer blockchain.Stop()
+
+	// URB #4 applies ERUs 8 and 9, the block of URB #3 is not known locally
+	txs := types.Transactions{
+		types.NewTransaction(0, common.HexToAddress("0x01"), big.NewInt(0), params.RequestTxGasLimit, params.RequestTxGasPrice, nil),
+		types.NewTransaction(0, common.HexToAddress("0x02"), big.NewInt(0), params.RequestTxGasLimit, params.RequestTxGasPrice, nil),
+	}
+	block := types.NewBlock(&types.Header{Number: big.NewInt(4), ParentHash: genesis.Hash()}, txs, nil, nil)
+	rawdb.WriteBlock(db, block)
+	rawdb.WriteCanonicalHash(db, block.Hash(), block.NumberU64())
+
+	backend := newTestRootChainBackend(rootchainContractABI)
+	backend.addEvent(t, "EpochPrepared", 5, big.NewInt(0), big.NewInt(2), big.NewInt(3), big.NewInt(4), big.NewInt(7), big.NewInt(9), false, true, true, false)
+	backend.Head = 5
+	backend.Handle("getEpoch", func([]interface{}) ([]interface{}, error) {
+		// user request epoch of blocks #3-#4, starting with URB #5
+		return []interface{}{
+			uint64(7), uint64(9), uint64(3), uint64(4), uint64(5), uint64(0), // requests, blocks, first request block, enters
+			false, true, true, true, false, // empty, initialized, request, user activated, rebase
+		}, nil
+	})
+	backend.Handle("URBs", func(args []interface{}) ([]interface{}, error) {
+		if id := args[0].(*big.Int).Uint64(); id == 5 {
+			return []interface{}{true, uint64(0), uint64(2), uint64(7), uint64(7), common.Address{}}, nil
+		}
+		return []interface{}{true, uint64(0), uint64(2), uint64(8), uint64(9), common.Address{}}, nil
+	})
+	backend.Handle("ERUs", func(args []interface{}) ([]interface{}, error) {
+		if id := args[0].(*big.Int).Uint64(); id > 10 {
+			return nil, errors.New("invalid opcode")
+		}
+		return []interface{}{
+			uint64(0), false, true, false, false, big.NewInt(0),
+			common.Address{}, common.Address{}, [32]byte{}, [32]byte{}, [32]byte{},
+		}, nil
+	})
+	rcm := backend.manager(&Config{})
+	rcm.blockchain = blockchain
+	rcm.state = &rootchainState{rcm: rcm}
+	if _, err := rcm.events.catchUp(context.Background()); err != nil {
+		t.Fatalf("failed to index events: %v", err)
+	}
+
+	tests := []struct {
+		requestId uint64
+		index     int
+		err       string
+	}{
+		{8, 0, ""},
+		{9, 1, ""},
+		{7, 0, "request block #3 not found"},
+		{10, 0, "not included in a user request block"},
+		{11, 0, "request 11 not found"},
+	}
+	for i, tt := range tests {
+		found, index, err := rcm.findRequestTx(tt.requestId, true)
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("test %d: error mismatch: have %v, want %q", i, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test %d: failed to find request: %v", i, err)
+			continue
+		}
+		if found.Hash() != block.Hash() || index != tt.index {
+			t.Errorf("test %d: request tx mismatch: have tx %d of block #%d, want tx %d of block #4", i, index, found.NumberU64(), tt.index)
+		}
+	}
+}
+
+// Tests that the request transaction of a transfer enter pays the target of the
+// request rather than the requestor, exactly as the RootChain contract encodes
+// the request transaction.
+func TestTransferRequestTx(t *testing.T) {
+	var (
+		requestor = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		to        = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		value     = big.NewInt(1000)
+	)
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(ethdb.NewMemDatabase()))
+	statedb.AddBalance(requestor, big.NewInt(params.Ether))
+
+	cfg := &runtime.Config{State: statedb, Origin: requestor, GasLimit: 8000000, BlockNumber: big.NewInt(1)}
+
+	// Deploy the RootChain contract with its epoch handler
+	_, epochHandler, _, err := runtime.Create(common.FromHex(epochhandler.EpochHandlerBin), cfg)
+	if err != nil {
+		t.Fatalf("failed to deploy epoch handler: %v", err)
+	}
+	args, err := rootchainContractABI.Pack("", epochHandler, true, big.NewInt(2), [32]byte{1}, [32]byte{2}, [32]byte{3})
+	if err != nil {
+		t.Fatalf("failed to pack constructor: %v", err)
+	}
+	_, contract, _, err := runtime.Create(append(common.FromHex(rootchain.RootChainBin), args...), cfg)
+	if err != nil {
+		t.Fatalf("failed to deploy RootChain: %v", err)
+	}
+	call := func(value *big.Int, method string, args ...interface{}) []byte {
+		input, err := rootchainContractABI.Pack(method, args...)
+		if err != nil {
+			t.Fatalf("failed to pack %s: %v", method, err)
+		}
+		cfg.Value = value
+		ret, _, err := runtime.Call(contract, input, cfg)
+		if err != nil {
+			t.Fatalf("failed to call %s: %v", method, err)
+		}
+		return ret
+	}
+
+	// Enter a transfer of ether to another account
+	call(value, "startEnter", true, to, [32]byte{}, [32]byte{})
+
+	var ero struct {
+		Timestamp  uint64
+		IsExit     bool
+		IsTransfer bool
+		Finalized  bool
+		Challenged bool
+		Value      *big.Int
+		Requestor  common.Address
+		To         common.Address
+		TrieKey    [32]byte
+		TrieValue  [32]byte
+		Hash       [32]byte
+	}
+	if err := rootchainContractABI.Unpack(&ero, "EROs", call(nil, "EROs", big.NewInt(0))); err != nil {
+		t.Fatalf("failed to unpack request: %v", err)
+	}
+	if ero.Requestor != requestor || ero.To != to || !ero.IsTransfer {
+		t.Fatalf("unexpected request: %+v", ero)
+	}
+	var eroBytes []byte
+	if err := rootchainContractABI.Unpack(&eroBytes, "getEROBytes", call(nil, "getEROBytes", big.NewInt(0))); err != nil {
+		t.Fatalf("failed to unpack request transaction: %v", err)
+	}
+
+	rcm := new(RootChainManager)
+	tx, err := rcm.requestTx(0, &request{
+		IsTransfer: ero.IsTransfer,
+		Value:      ero.Value,
+		Requestor:  ero.Requestor,
+		To:         ero.To,
+		TrieKey:    ero.TrieKey,
+		TrieValue:  ero.TrieValue,
+	})
+	if err != nil {
+		t.Fatalf("failed to create request transaction: %v", err)
+	}
+	if *tx.To() != to {
+		t.Errorf("request transaction recipient mismatch: have %x, want %x", *tx.To(), to)
+	}
+	if tx.Value().Cmp(value) != 0 {
+		t.Errorf("request transaction value mismatch: have %v, want %v", tx.Value(), value)
+	}
+	if !bytes.Equal(tx.GetRlp(), eroBytes) {
+		t.Errorf("request transaction mismatch: have %x, want %x", tx.GetRlp(), eroBytes)
+	}
+}
@@ -23,6 +23,7 @@ type rootchainState struct {
 	requestGas     uint64
 	lastEpoch      uint64
 	currentFork    uint64
+	forkLock       sync.RWMutex // Protects lastEpoch and currentFork, rewound by forks
 
 	// operator tx parameters
 	nonce uint64
@@ -36,8 +37,8 @@ func newRootchainState(rcm *RootChainManager) *rootchainState {
 	rs := &rootchainState{rcm: rcm}
 
 	// TODO: read rs from DB. if null, read from contract
-	rs.costERU = rs.getCostERO()
-	rs.costERO = rs.getCostERU()
+	rs.costERO = rs.getCostERO()
+	rs.costERU = rs.getCostERU()
 	rs.costURBPrepare = rs.getCostURBPrepare()
 	rs.costURB = rs.getCostURB()
 	rs.costORB = rs.getCostORB()
@@ -45,7 +46,7 @@ func newRootchainState(rcm *RootChainManager) *rootchainState {
 	rs.maxRequests = rs.getMaxRequests()
 	rs.requestGas = rs.getRequestGas()
 	rs.lastEpoch = rs.getLastEpoch()
-	rs.currentFork = rs.getCurrentFork()
+	rs.currentFork = rs.fetchCurrentFork()
 
 	rs.getNonce()
 
@@ -88,10 +89,23 @@ func (rs *rootchainState) getLastEpoch() uint64 {
 	fork, _ := rs.rcm.rootchainContract.Forks(baseCallOpt, big.NewInt(int64(rs.currentFork)))
 	return fork.LastEpoch
 }
-func (rs *rootchainState) getCurrentFork() uint64 {
+func (rs *rootchainState) fetchCurrentFork() uint64 {
 	fork, _ := rs.rcm.rootchainContract.CurrentFork(baseCallOpt)
 	return fork.Uint64()
 }
+func (rs *rootchainState) getCurrentFork() uint64 {
+	rs.forkLock.RLock()
+	defer rs.forkLock.RUnlock()
+
+	return rs.currentFork
+}
+func (rs *rootchainState) setCurrentFork(forkNumber, lastEpoch uint64) {
+	rs.forkLock.Lock()
+	defer rs.forkLock.Unlock()
+
+	rs.currentFork = forkNumber
+	rs.lastEpoch = lastEpoch
+}
 func (rs *rootchainState) getNonce() uint64 {
 	rs.lock.Lock()
 	defer rs.lock.Unlock()
@@ -0,0 +1,61 @@
+package pls
+
+import (
+	"math/big"
+	"testing"
+)
+
+// Tests that every cost of the RootChain contract is read into its own field.
+func TestRootchainStateCosts(t *testing.T) {
+	backend := newTestRootChainBackend(rootchainContractABI)
+	costs := map[string]int64{
+		"COST_ERO":         1,
+		"COST_ERU":         2,
+		"COST_URB_PREPARE": 3,
+		"COST_URB":         4,
+		"COST_ORB":         5,
+		"COST_NRB":         6,
+	}
+	for method, cost := range costs {
+		cost := big.NewInt(cost)
+		backend.Handle(method, func([]interface{}) ([]interface{}, error) {
+			return []interface{}{cost}, nil
+		})
+	}
+	rs := newRootchainState(backend.manager(&Config{}))
+
+	for method, have := range map[string]uint64{
+		"COST_ERO":         rs.costERO,
+		"COST_ERU":         rs.costERU,
+		"COST_URB_PREPARE": rs.costURBPrepare,
+		"COST_URB":         rs.costURB,
+		"COST_ORB":         rs.costORB,
+		"COST_NRB":         rs.costNRB,
+	} {
+		if want := uint64(costs[method]); have != want {
+			t.Errorf("%s mismatch: have %d, want %d", method, have, want)
+		}
+	}
+}
+
+// Tests that the current fork is read consistently while a fork rewind sets it,
+// run with -race to catch unguarded accesses.
+func TestRootchainStateCurrentFork(t *testing.T) {
+	rs := &rootchainState{}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for fork := uint64(1); fork <= 100; fork++ {
+			rs.setCurrentFork(fork, fork*10)
+		}
+	}()
+	last := uint64(0)
+	for fork := rs.getCurrentFork(); fork < 100; fork = rs.getCurrentFork() {
+		if fork < last {
+			t.Fatalf("current fork went back: have %d, previous %d", fork, last)
+		}
+		last = fork
+	}
+	<-done
+}
@@ -0,0 +1,511 @@
+package pls
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/common/hexutil"
+)
+
+const (
+	// maxPendingRequestScan is the maximum number of trailing enter and exit
+	// requests inspected to count the pending requests.
+	maxPendingRequestScan = 1024
+
+	// maxSubmissionHistory is the number of block submissions remembered.
+	maxSubmissionHistory = 64
+
+	// maxPlasmaStatusAge is the time after which the cached plasma status is
+	// refreshed even without rootchain events, e.g. for the operator balance.
+	maxPlasmaStatusAge = time.Minute
+)
+
+// Submission states of a block submission transaction.
+const (
+	SubmissionPending  = "pending"
+	SubmissionMined    = "mined"
+	SubmissionReverted = "reverted"
+	SubmissionFailed   = "failed"
+)
+
+// PlasmaStatus is a snapshot of the plasma chain as tracked by the operator on
+// the RootChain contract.
+type PlasmaStatus struct {
+	CurrentFork        uint64
+	CurrentEpoch       uint64
+	EpochType          string // NRE, ORE or URE
+	LastSubmittedBlock uint64
+	LastFinalizedBlock uint64
+	PendingEnters      int
+	PendingExits       int
+	OperatorBalance    *big.Int
+	SubmissionLatency  time.Duration // time between mining and submitting the last block
+}
+
+// Submission is a transaction submitting a plasma block to the RootChain
+// contract.
+type Submission struct {
+	BlockNumber uint64      `json:"blockNumber"`
+	Method      string      `json:"method"` // submitNRB or submitORB
+	TxHash      common.Hash `json:"transactionHash"`
+	GasUsed     uint64      `json:"gasUsed"`
+	Status      string      `json:"status"`
+	Time        time.Time   `json:"time"`
+}
+
+// EpochStatus is the rootchain status of a plasma epoch.
+type EpochStatus struct {
+	ForkNumber       uint64
+	EpochNumber      uint64
+	Type             string // NRE, ORE or URE
+	StartBlockNumber uint64
+	EndBlockNumber   uint64
+	IsEmpty          bool
+	Submitted        bool
+	Finalized        bool
+}
+
+// InvalidExit is a failed exit request detected in a request block mined by the
+// operator, along with the challenge sent against it.
+type InvalidExit struct {
+	ForkNumber  uint64
+	BlockNumber uint64
+	Index       int64
+	TxHash      common.Hash
+	Reason      string
+	ChallengeTx common.Hash // zero until the exit is challenged
+}
+
+// statusCache is the plasma status read from the rootchain, refreshed once the
+// rootchain event index changes.
+type statusCache struct {
+	status  *PlasmaStatus
+	changes uint64    // change count of the event index at the last refresh
+	updated time.Time // time of the last refresh
+
+	lock sync.Mutex
+}
+
+// submissionStats tracks the blocks submitted by the operator.
+type submissionStats struct {
+	history []*Submission // the latest block submissions, oldest first
+	latency time.Duration
+
+	lock sync.RWMutex
+}
+
+// sending records a block submission transaction sent to the rootchain.
+func (s *submissionStats) sending(number uint64, method string, tx common.Hash) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.history = append(s.history, &Submission{
+		BlockNumber: number,
+		Method:      method,
+		TxHash:      tx,
+		Status:      SubmissionPending,
+		Time:        time.Now(),
+	})
+	if len(s.history) > maxSubmissionHistory {
+		s.history = s.history[len(s.history)-maxSubmissionHistory:]
+	}
+}
+
+// done records the outcome of a block submission. The submission latency is
+// only updated by the blocks submitted successfully.
+func (s *submissionStats) done(tx common.Hash, status string, gasUsed uint64, minedAt time.Time) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for i := len(s.history) - 1; i >= 0; i-- {
+		if sub := s.history[i]; sub.TxHash == tx {
+			sub.Status, sub.GasUsed = status, gasUsed
+			break
+		}
+	}
+	if status == SubmissionMined {
+		s.latency = time.Since(minedAt)
+	}
+}
+
+// Submissions returns the latest block submissions of the operator, oldest
+// first.
+func (rcm *RootChainManager) Submissions() []*Submission {
+	rcm.submissions.lock.RLock()
+	defer rcm.submissions.lock.RUnlock()
+
+	subs := make([]*Submission, len(rcm.submissions.history))
+	for i, sub := range rcm.submissions.history {
+		cpy := *sub
+		subs[i] = &cpy
+	}
+	return subs
+}
+
+// Epochs returns the rootchain status of the last n epochs of the current fork,
+// oldest first.
+func (rcm *RootChainManager) Epochs(n int) ([]*EpochStatus, error) {
+	fork := new(big.Int).SetUint64(rcm.state.getCurrentFork())
+
+	lastEpoch, err := rcm.rootchainContract.LastEpoch(baseCallOpt, fork)
+	if err != nil {
+		return nil, err
+	}
+	lastBlock, err := rcm.rootchainContract.LastBlock(baseCallOpt, fork)
+	if err != nil {
+		return nil, err
+	}
+	lastFinalized, err := rcm.rootchainContract.GetLastFinalizedBlock(baseCallOpt, fork)
+	if err != nil {
+		return nil, err
+	}
+
+	var first uint64
+	if last := lastEpoch.Uint64(); last+1 > uint64(n) {
+		first = last + 1 - uint64(n)
+	}
+	var epochs []*EpochStatus
+	for number := first; number <= lastEpoch.Uint64(); number++ {
+		epoch, err := rcm.getEpoch(fork, new(big.Int).SetUint64(number))
+		if err != nil {
+			return nil, err
+		}
+		epochs = append(epochs, &EpochStatus{
+			ForkNumber:       fork.Uint64(),
+			EpochNumber:      number,
+			Type:             epochType(epoch),
+			StartBlockNumber: epoch.StartBlockNumber,
+			EndBlockNumber:   epoch.EndBlockNumber,
+			IsEmpty:          epoch.IsEmpty,
+			Submitted:        epoch.EndBlockNumber <= lastBlock.Uint64(),
+			Finalized:        epoch.EndBlockNumber <= lastFinalized.Uint64(),
+		})
+	}
+	return epochs, nil
+}
+
+// invalidExitStats is a snapshot of the invalid exits detected by the operator.
+// It is guarded by its own lock, so reading it does not wait for the rootchain
+// manager, which holds its lock during whole rootchain round trips.
+type invalidExitStats struct {
+	exits []*InvalidExit // ordered by fork, block number and transaction index
+
+	lock sync.RWMutex
+}
+
+// update replaces the snapshot with the current invalid exits. The caller has
+// to hold the lock of the rootchain manager.
+func (s *invalidExitStats) update(detected map[uint64]map[uint64]invalidExits) {
+	var exits []*InvalidExit
+	for fork, blocks := range detected {
+		for number, list := range blocks {
+			for _, ie := range list {
+				exits = append(exits, &InvalidExit{
+					ForkNumber:  fork,
+					BlockNumber: number,
+					Index:       ie.index,
+					TxHash:      ie.receipt.TxHash,
+					Reason:      ie.receipt.RevertReason,
+					ChallengeTx: ie.challengeTx,
+				})
+			}
+		}
+	}
+	sort.Slice(exits, func(i, j int) bool {
+		a, b := exits[i], exits[j]
+		if a.ForkNumber != b.ForkNumber {
+			return a.ForkNumber < b.ForkNumber
+		}
+		if a.BlockNumber != b.BlockNumber {
+			return a.BlockNumber < b.BlockNumber
+		}
+		return a.Index < b.Index
+	})
+
+	s.lock.Lock()
+	s.exits = exits
+	s.lock.Unlock()
+}
+
+// InvalidExits returns the invalid exits detected by the operator, ordered by
+// fork, block number and transaction index.
+func (rcm *RootChainManager) InvalidExits() []*InvalidExit {
+	rcm.invalidExitStats.lock.RLock()
+	defer rcm.invalidExitStats.lock.RUnlock()
+
+	exits := make([]*InvalidExit, len(rcm.invalidExitStats.exits))
+	for i, ie := range rcm.invalidExitStats.exits {
+		cpy := *ie
+		exits[i] = &cpy
+	}
+	return exits
+}
+
+// epochType returns the short name of the kind of the epoch.
+func epochType(epoch *PlasmaEpoch) string {
+	switch {
+	case !epoch.IsRequest:
+		return "NRE"
+	case epoch.UserActivated:
+		return "URE"
+	default:
+		return "ORE"
+	}
+}
+
+// Status returns the current status of the plasma chain on the rootchain. The
+// status is cached until a rootchain event is indexed, so frequent callers like
+// the stats reporter don't query the RootChain contract every time.
+func (rcm *RootChainManager) Status() (*PlasmaStatus, error) {
+	cache := &rcm.statusCache
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	var changes uint64
+	if rcm.events != nil {
+		changes = rcm.events.changeCount()
+	}
+	if cache.status == nil || rcm.events == nil || changes != cache.changes || time.Since(cache.updated) > maxPlasmaStatusAge {
+		status, err := rcm.loadStatus()
+		if err != nil {
+			return nil, err
+		}
+		cache.status, cache.changes, cache.updated = status, changes, time.Now()
+	}
+	status := *cache.status
+
+	rcm.submissions.lock.RLock()
+	status.SubmissionLatency = rcm.submissions.latency
+	rcm.submissions.lock.RUnlock()
+
+	return &status, nil
+}
+
+// loadStatus reads the current status of the plasma chain from the rootchain.
+func (rcm *RootChainManager) loadStatus() (*PlasmaStatus, error) {
+	fork := new(big.Int).SetUint64(rcm.state.getCurrentFork())
+
+	lastEpoch, err := rcm.rootchainContract.LastEpoch(baseCallOpt, fork)
+	if err != nil {
+		return nil, err
+	}
+	epoch, err := rcm.getEpoch(fork, lastEpoch)
+	if err != nil {
+		return nil, err
+	}
+	lastBlock, err := rcm.rootchainContract.LastBlock(baseCallOpt, fork)
+	if err != nil {
+		return nil, err
+	}
+	lastFinalized, err := rcm.rootchainContract.GetLastFinalizedBlock(baseCallOpt, fork)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := rcm.backend.BalanceAt(context.Background(), rcm.config.Operator.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	enters, exits, err := rcm.pendingRequests()
+	if err != nil {
+		return nil, err
+	}
+	return &PlasmaStatus{
+		CurrentFork:        fork.Uint64(),
+		CurrentEpoch:       lastEpoch.Uint64(),
+		EpochType:          epochType(epoch),
+		LastSubmittedBlock: lastBlock.Uint64(),
+		LastFinalizedBlock: lastFinalized.Uint64(),
+		PendingEnters:      enters,
+		PendingExits:       exits,
+		OperatorBalance:    balance,
+	}, nil
+}
+
+// pendingRequests counts the enter and exit requests which are not finalized
+// yet.
+func (rcm *RootChainManager) pendingRequests() (enters int, exits int, err error) {
+	reqs, err := rcm.PendingRequests()
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, req := range reqs {
+		if req.IsExit {
+			exits++
+		} else {
+			enters++
+		}
+	}
+	return enters, exits, nil
+}
+
+// PendingRequest is an enter or exit request of an operator request block which
+// is not finalized yet.
+type PendingRequest struct {
+	RequestId  hexutil.Uint64 `json:"requestId"`
+	IsExit     bool           `json:"isExit"`
+	IsTransfer bool           `json:"isTransfer"`
+	Challenged bool           `json:"challenged"`
+	Requestor  common.Address `json:"requestor"`
+	To         common.Address `json:"to"`
+	Value      *hexutil.Big   `json:"value"`
+	Timestamp  hexutil.Uint64 `json:"timestamp"`
+}
+
+// PendingRequests returns the enter and exit requests which are not finalized
+// yet, oldest first. Requests are finalized in order, so the scan stops at the
+// first finalized one.
+func (rcm *RootChainManager) PendingRequests() ([]*PendingRequest, error) {
+	numEROs, err := rcm.rootchainContract.GetNumEROs(baseCallOpt)
+	if err != nil {
+		return nil, err
+	}
+	var reqs []*PendingRequest
+	for i := uint64(0); i < numEROs.Uint64() && i < maxPendingRequestScan; i++ {
+		id := numEROs.Uint64() - 1 - i
+
+		ero, err := rcm.rootchainContract.EROs(baseCallOpt, new(big.Int).SetUint64(id))
+		if err != nil {
+			rootchainErrorMeter.Mark(1)
+			return nil, err
+		}
+		if ero.Finalized {
+			break
+		}
+		reqs = append(reqs, &PendingRequest{
+			RequestId:  hexutil.Uint64(id),
+			IsExit:     ero.IsExit,
+			IsTransfer: ero.IsTransfer,
+			Challenged: ero.Challenged,
+			Requestor:  ero.Requestor,
+			To:         ero.To,
+			Value:      (*hexutil.Big)(ero.Value),
+			Timestamp:  hexutil.Uint64(ero.Timestamp),
+		})
+	}
+	// Requests were collected newest first
+	for i, j := 0, len(reqs)-1; i < j; i, j = i+1, j-1 {
+		reqs[i], reqs[j] = reqs[j], reqs[i]
+	}
+	return reqs, nil
+}
+
+// RPCEpoch is the rootchain status of a plasma epoch as reported over RPC.
+type RPCEpoch struct {
+	ForkNumber       hexutil.Uint64 `json:"forkNumber"`
+	EpochNumber      hexutil.Uint64 `json:"epochNumber"`
+	Type             string         `json:"type"`
+	StartBlockNumber hexutil.Uint64 `json:"startBlockNumber"`
+	EndBlockNumber   hexutil.Uint64 `json:"endBlockNumber"`
+	RequestStart     hexutil.Uint64 `json:"requestStart"`
+	RequestEnd       hexutil.Uint64 `json:"requestEnd"`
+	NumEnter         hexutil.Uint64 `json:"numEnter"`
+	IsEmpty          bool           `json:"isEmpty"`
+	Rebase           bool           `json:"rebase"`
+	Submitted        bool           `json:"submitted"`
+	Finalized        bool           `json:"finalized"`
+}
+
+// currentEpoch returns the last epoch of the current fork.
+func (rcm *RootChainManager) currentEpoch() (*RPCEpoch, error) {
+	fork := new(big.Int).SetUint64(rcm.state.getCurrentFork())
+
+	lastEpoch, err := rcm.rootchainContract.LastEpoch(baseCallOpt, fork)
+	if err != nil {
+		return nil, err
+	}
+	epoch, err := rcm.getEpoch(fork, lastEpoch)
+	if err != nil {
+		return nil, err
+	}
+	lastBlock, err := rcm.rootchainContract.LastBlock(baseCallOpt, fork)
+	if err != nil {
+		return nil, err
+	}
+	lastFinalized, err := rcm.rootchainContract.GetLastFinalizedBlock(baseCallOpt, fork)
+	if err != nil {
+		return nil, err
+	}
+	return &RPCEpoch{
+		ForkNumber:       hexutil.Uint64(fork.Uint64()),
+		EpochNumber:      hexutil.Uint64(lastEpoch.Uint64()),
+		Type:             epochType(epoch),
+		StartBlockNumber: hexutil.Uint64(epoch.StartBlockNumber),
+		EndBlockNumber:   hexutil.Uint64(epoch.EndBlockNumber),
+		RequestStart:     hexutil.Uint64(epoch.RequestStart),
+		RequestEnd:       hexutil.Uint64(epoch.RequestEnd),
+		NumEnter:         hexutil.Uint64(epoch.NumEnter),
+		IsEmpty:          epoch.IsEmpty,
+		Rebase:           epoch.Rebase,
+		Submitted:        epoch.EndBlockNumber <= lastBlock.Uint64(),
+		Finalized:        epoch.EndBlockNumber <= lastFinalized.Uint64(),
+	}, nil
+}
+
+// RPCPlasmaBlock is a plasma block submitted to the RootChain contract, along
+// with the matching local block, as reported over RPC.
+type RPCPlasmaBlock struct {
+	ForkNumber       hexutil.Uint64 `json:"forkNumber"`
+	Number           hexutil.Uint64 `json:"number"`
+	EpochNumber      hexutil.Uint64 `json:"epochNumber"`
+	RequestBlockId   hexutil.Uint64 `json:"requestBlockId"`
+	Timestamp        hexutil.Uint64 `json:"timestamp"`
+	StatesRoot       common.Hash    `json:"statesRoot"`
+	TransactionsRoot common.Hash    `json:"transactionsRoot"`
+	ReceiptsRoot     common.Hash    `json:"receiptsRoot"`
+	IsRequest        bool           `json:"isRequest"`
+	UserActivated    bool           `json:"userActivated"`
+	Challenged       bool           `json:"challenged"`
+	Challenging      bool           `json:"challenging"`
+	Finalized        bool           `json:"finalized"`
+
+	// Hash is the hash of the local block with the same number, nil if the block
+	// is not known locally. RootsMatch reports whether its roots equal the
+	// submitted ones.
+	Hash       *common.Hash `json:"hash"`
+	RootsMatch bool         `json:"rootsMatch"`
+}
+
+// plasmaBlock returns the given block submitted to the current fork.
+func (rcm *RootChainManager) plasmaBlock(number uint64) (*RPCPlasmaBlock, error) {
+	fork := new(big.Int).SetUint64(rcm.state.getCurrentFork())
+
+	lastBlock, err := rcm.rootchainContract.LastBlock(baseCallOpt, fork)
+	if err != nil {
+		return nil, err
+	}
+	if number == 0 || number > lastBlock.Uint64() {
+		return nil, fmt.Errorf("block #%d not submitted in fork %d", number, fork)
+	}
+	block, err := rcm.getBlock(fork, new(big.Int).SetUint64(number))
+	if err != nil {
+		return nil, err
+	}
+	result := &RPCPlasmaBlock{
+		ForkNumber:       hexutil.Uint64(fork.Uint64()),
+		Number:           hexutil.Uint64(number),
+		EpochNumber:      hexutil.Uint64(block.EpochNumber),
+		RequestBlockId:   hexutil.Uint64(block.RequestBlockId),
+		Timestamp:        hexutil.Uint64(block.Timestamp),
+		StatesRoot:       block.StatesRoot,
+		TransactionsRoot: block.TransactionsRoot,
+		ReceiptsRoot:     block.ReceiptsRoot,
+		IsRequest:        block.IsRequest,
+		UserActivated:    block.UserActivated,
+		Challenged:       block.Challenged,
+		Challenging:      block.Challenging,
+		Finalized:        block.Finalized,
+	}
+	if local := rcm.blockchain.GetBlockByNumber(number); local != nil {
+		hash := local.Hash()
+		result.Hash = &hash
+		result.RootsMatch = local.Root() == result.StatesRoot &&
+			local.TxHash() == result.TransactionsRoot &&
+			local.ReceiptHash() == result.ReceiptsRoot
+	}
+	return result, nil
+}
@@ -0,0 +1,284 @@
+package pls
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Onther-Tech/plasma-evm/accounts/abi/bind"
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/common/hexutil"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/log"
+)
+
+// SubmittedBlock is a block submitted to the RootChain contract which is
+// tracked by the withholding watcher.
+type SubmittedBlock struct {
+	ForkNumber  hexutil.Uint64 `json:"forkNumber"`
+	EpochNumber hexutil.Uint64 `json:"epochNumber"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	StatesRoot  common.Hash    `json:"statesRoot"`
+	SubmittedAt time.Time      `json:"submittedAt"`
+	Withheld    bool           `json:"withheld"`
+}
+
+// WithholdingStatus is the state of the withholding watcher.
+type WithholdingStatus struct {
+	Timeout       string            `json:"timeout"`
+	Pending       []*SubmittedBlock `json:"pending"`
+	Withheld      []*SubmittedBlock `json:"withheld"`
+	PreparedExits []*ExitRequest    `json:"preparedExits"`
+	PreparedERUs  []*ExitRequest    `json:"preparedERUs"`
+}
+
+// submittedBlockKey identifies a submitted block, as the blocks of a new fork
+// are submitted again with the numbers of the blocks they replace.
+type submittedBlockKey struct {
+	fork   uint64
+	number uint64
+}
+
+// withholdingWatcher correlates BlockSubmitted events of the RootChain contract
+// with locally available blocks. A submitted block whose body does not show up
+// within the timeout is considered to be withheld by the operator.
+type withholdingWatcher struct {
+	rcm     *RootChainManager
+	timeout time.Duration
+
+	// hasBlock reports whether the block with the given number and state root
+	// is available locally.
+	hasBlock func(number uint64, root common.Hash) bool
+
+	blocks        map[submittedBlockKey]*SubmittedBlock
+	preparedExits []*ExitRequest
+	preparedERUs  []*ExitRequest
+
+	lock sync.RWMutex
+}
+
+func newWithholdingWatcher(rcm *RootChainManager, timeout time.Duration) *withholdingWatcher {
+	ww := &withholdingWatcher{
+		rcm:     rcm,
+		timeout: timeout,
+		blocks:  make(map[submittedBlockKey]*SubmittedBlock),
+	}
+	ww.hasBlock = func(number uint64, root common.Hash) bool {
+		block := rcm.blockchain.GetBlockByNumber(number)
+		return block != nil && block.Root() == root
+	}
+	return ww
+}
+
+func (ww *withholdingWatcher) run() {
+	submittedCh := make(chan *rootchain.RootChainBlockSubmitted)
+	watchOpts := &bind.WatchOpts{
+		Start:   nil,
+		Context: context.Background(),
+	}
+	sub, err := ww.rcm.rootchainContract.WatchBlockSubmitted(watchOpts, submittedCh)
+	if err != nil {
+		log.Error("Failed to watch BlockSubmitted event", "err", err)
+		return
+	}
+	defer sub.Unsubscribe()
+
+	ticker := time.NewTicker(ww.timeout / 2)
+	defer ticker.Stop()
+
+	log.Info("Watching block withholding", "timeout", ww.timeout)
+
+	for {
+		select {
+		case e := <-submittedCh:
+			if e == nil {
+				continue
+			}
+			block, err := ww.rcm.getBlock(e.Fork, e.BlockNumber)
+			if err != nil {
+				log.Error("Failed to get submitted block", "fork", e.Fork, "blockNumber", e.BlockNumber, "err", err)
+				continue
+			}
+			ww.add(e.Fork.Uint64(), e.EpochNumber.Uint64(), e.BlockNumber.Uint64(), common.Hash(block.StatesRoot), time.Now())
+
+		case <-ticker.C:
+			ww.check(time.Now())
+
+		case err := <-sub.Err():
+			log.Error("BlockSubmitted event subscription error", "err", err)
+			return
+
+		case <-ww.rcm.quit:
+			return
+		}
+	}
+}
+
+// add starts tracking a submitted block unless it is already available.
+func (ww *withholdingWatcher) add(fork, epoch, number uint64, root common.Hash, now time.Time) {
+	if ww.hasBlock(number, root) {
+		return
+	}
+
+	ww.lock.Lock()
+	defer ww.lock.Unlock()
+
+	key := submittedBlockKey{fork, number}
+	if _, ok := ww.blocks[key]; ok {
+		return
+	}
+	ww.blocks[key] = &SubmittedBlock{
+		ForkNumber:  hexutil.Uint64(fork),
+		EpochNumber: hexutil.Uint64(epoch),
+		BlockNumber: hexutil.Uint64(number),
+		StatesRoot:  root,
+		SubmittedAt: now,
+	}
+	log.Debug("Submitted block is not available yet", "fork", fork, "blockNumber", number, "statesRoot", root)
+}
+
+// check drops the tracked blocks which became available and flags the blocks
+// missing for longer than the timeout as withheld.
+func (ww *withholdingWatcher) check(now time.Time) {
+	// Exits are prepared without holding the lock, as they need rootchain
+	// calls and state access which would block the other watcher callers.
+	withheld := ww.collect(now)
+	if ww.rcm.config.WithholdingPrepareExit {
+		for _, number := range withheld {
+			ww.prepareExits(number)
+		}
+	}
+}
+
+// collect updates the tracked blocks and returns the numbers of the blocks
+// newly flagged as withheld.
+func (ww *withholdingWatcher) collect(now time.Time) []uint64 {
+	ww.lock.Lock()
+	defer ww.lock.Unlock()
+
+	var withheld []uint64
+	for key, b := range ww.blocks {
+		number := key.number
+		if ww.hasBlock(number, b.StatesRoot) {
+			if b.Withheld {
+				withheldBlockCounter.Dec(1)
+				log.Info("Withheld block became available", "fork", b.ForkNumber, "blockNumber", number)
+			}
+			delete(ww.blocks, key)
+			continue
+		}
+		if b.Withheld || now.Sub(b.SubmittedAt) < ww.timeout {
+			continue
+		}
+		b.Withheld = true
+
+		withheldBlockMeter.Mark(1)
+		withheldBlockCounter.Inc(1)
+		log.Warn("Submitted block is withheld", "fork", b.ForkNumber, "epochNumber", b.EpochNumber, "blockNumber", number, "statesRoot", b.StatesRoot, "submittedAt", b.SubmittedAt)
+
+		go ww.rcm.eventMux.Post(BlockWithheldEvent{
+			ForkNumber:  new(big.Int).SetUint64(uint64(b.ForkNumber)),
+			EpochNumber: new(big.Int).SetUint64(uint64(b.EpochNumber)),
+			BlockNumber: new(big.Int).SetUint64(number),
+			StatesRoot:  b.StatesRoot,
+			SubmittedAt: b.SubmittedAt,
+		})
+
+		withheld = append(withheld, number)
+	}
+	sort.Slice(withheld, func(i, j int) bool { return withheld[i] < withheld[j] })
+	return withheld
+}
+
+// prepareExits builds exit requests and ERUs for the assets of the local accounts
+// based on the last block before the withheld one. EROs need the operator to
+// include them in a request block, ERUs force a user request block otherwise.
+func (ww *withholdingWatcher) prepareExits(withheld uint64) {
+	if withheld == 0 || ww.rcm.accountManager == nil {
+		return
+	}
+	block := ww.rcm.blockchain.GetBlockByNumber(withheld - 1)
+	if block == nil {
+		return
+	}
+	statedb, err := ww.rcm.blockchain.StateAt(block.Root())
+	if err != nil {
+		log.Error("Failed to get state to prepare exits", "blockNumber", block.Number(), "err", err)
+		return
+	}
+
+	contracts, err := ww.rcm.requestableContracts()
+	if err != nil {
+		log.Error("Failed to get requestable contracts to prepare exits", "err", err)
+		return
+	}
+
+	exits, erus := make([]*ExitRequest, 0), make([]*ExitRequest, 0)
+	for _, wallet := range ww.rcm.accountManager.Wallets() {
+		for _, account := range wallet.Accounts() {
+			if account.Address == ww.rcm.config.Operator.Address {
+				continue
+			}
+			requests, err := ww.rcm.makeExitRequests(account.Address, statedb, block.Header(), contracts, false)
+			if err != nil {
+				log.Error("Failed to prepare exits", "requestor", account.Address, "err", err)
+				continue
+			}
+			userRequests, err := ww.rcm.makeExitRequests(account.Address, statedb, block.Header(), contracts, true)
+			if err != nil {
+				log.Error("Failed to prepare ERUs", "requestor", account.Address, "err", err)
+				continue
+			}
+			exits = append(exits, requests...)
+			erus = append(erus, userRequests...)
+			log.Info("Exits prepared for withheld block", "requestor", account.Address, "exits", len(requests), "erus", len(userRequests), "blockNumber", block.Number())
+		}
+	}
+	ww.lock.Lock()
+	ww.preparedExits, ww.preparedERUs = exits, erus
+	ww.lock.Unlock()
+}
+
+// rewind forgets the blocks of the previous forks from the given number, which
+// are replaced by the blocks of the new fork.
+func (ww *withholdingWatcher) rewind(fork, number uint64) {
+	ww.lock.Lock()
+	defer ww.lock.Unlock()
+
+	for key, b := range ww.blocks {
+		if key.fork < fork && key.number >= number {
+			if b.Withheld {
+				withheldBlockCounter.Dec(1)
+			}
+			delete(ww.blocks, key)
+		}
+	}
+}
+
+// status returns a copy of the watcher state.
+func (ww *withholdingWatcher) status() *WithholdingStatus {
+	ww.lock.RLock()
+	defer ww.lock.RUnlock()
+
+	status := &WithholdingStatus{
+		Timeout:       ww.timeout.String(),
+		Pending:       make([]*SubmittedBlock, 0),
+		Withheld:      make([]*SubmittedBlock, 0),
+		PreparedExits: ww.preparedExits,
+		PreparedERUs:  ww.preparedERUs,
+	}
+	for _, b := range ww.blocks {
+		cpy := *b
+		if b.Withheld {
+			status.Withheld = append(status.Withheld, &cpy)
+		} else {
+			status.Pending = append(status.Pending, &cpy)
+		}
+	}
+	sort.Slice(status.Pending, func(i, j int) bool { return status.Pending[i].BlockNumber < status.Pending[j].BlockNumber })
+	sort.Slice(status.Withheld, func(i, j int) bool { return status.Withheld[i].BlockNumber < status.Withheld[j].BlockNumber })
+
+	return status
+}
@@ -0,0 +1,78 @@
+package pls
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/event"
+)
+
+func TestWithholdingWatcher(t *testing.T) {
+	mux := new(event.TypeMux)
+	defer mux.Stop()
+
+	rcm := &RootChainManager{config: &Config{}, eventMux: mux}
+	ww := newWithholdingWatcher(rcm, time.Minute)
+
+	available := make(map[uint64]common.Hash)
+	ww.hasBlock = func(number uint64, root common.Hash) bool {
+		r, ok := available[number]
+		return ok && r == root
+	}
+
+	sub := mux.Subscribe(BlockWithheldEvent{})
+	defer sub.Unsubscribe()
+
+	root1, root2 := common.HexToHash("0x01"), common.HexToHash("0x02")
+	now := time.Now()
+
+	// block#1 is already available, block#2 is not
+	available[1] = root1
+	ww.add(0, 1, 1, root1, now)
+	ww.add(0, 1, 2, root2, now)
+
+	status := ww.status()
+	if len(status.Pending) != 1 || uint64(status.Pending[0].BlockNumber) != 2 {
+		t.Fatalf("pending blocks mismatch: have %v, want block#2", status.Pending)
+	}
+
+	// block#2 is not withheld before the timeout
+	ww.check(now.Add(30 * time.Second))
+	if status := ww.status(); len(status.Withheld) != 0 {
+		t.Fatalf("block withheld before timeout: %v", status.Withheld)
+	}
+
+	ww.check(now.Add(2 * time.Minute))
+	if status := ww.status(); len(status.Withheld) != 1 || len(status.Pending) != 0 {
+		t.Fatalf("block#2 should be withheld: %+v", status)
+	}
+
+	select {
+	case ev := <-sub.Chan():
+		e := ev.Data.(BlockWithheldEvent)
+		if e.BlockNumber.Uint64() != 2 || e.StatesRoot != root2 {
+			t.Fatalf("withheld event mismatch: %+v", e)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("withheld event is not posted")
+	}
+
+	// block#2 is submitted again by a new fork, without aliasing the withheld one
+	ww.add(1, 1, 2, root1, now.Add(2*time.Minute))
+	if status := ww.status(); len(status.Withheld) != 1 || len(status.Pending) != 1 || status.Pending[0].ForkNumber != 1 {
+		t.Fatalf("new fork block mismatch: %+v", status)
+	}
+	// rewinding to the new fork forgets the blocks of the old one
+	ww.rewind(1, 2)
+	if status := ww.status(); len(status.Withheld) != 0 || len(status.Pending) != 1 || status.Pending[0].ForkNumber != 1 {
+		t.Fatalf("rewound blocks mismatch: %+v", status)
+	}
+
+	// block#2 of the new fork shows up eventually
+	available[2] = root1
+	ww.check(now.Add(3 * time.Minute))
+	if status := ww.status(); len(status.Withheld) != 0 || len(status.Pending) != 0 {
+		t.Fatalf("available block is still tracked: %+v", status)
+	}
+}
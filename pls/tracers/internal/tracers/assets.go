@@ -7,6 +7,7 @@
 // noop_tracer.js (1.271kB)
 // opcount_tracer.js (1.372kB)
 // prestate_tracer.js (3.892kB)
+// request_tracer.js (4.082kB)
 // trigram_tracer.js (1.788kB)
 // unigram_tracer.js (1.51kB)
 
@@ -217,6 +218,26 @@ func prestate_tracerJs() (*asset, error) {
 	return a, nil
 }
 
+var _request_tracerJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x57\x6d\x53\xdb\x38\x10\xfe\x4c\x7e\xc5\xf6\x4b\x93\x1c\xa9\x13\x5e\x4a\x4b\x20\xbd\xc9\x71\xd0\x66\x8e\x02\x93\x84\x76\xb8\x9b\xfb\xa0\xc4\xb2\xad\xa9\xb1\x7c\x92\x4c\xc8\x50\xfe\xfb\xed\x4a\xb2\xe3\x40\x68\xcb\x4c\x1b\x22\xed\x3e\xfb\xec\xab\x96\x6e\x17\x4e\x64\xbe\x54\x22\x4e\x0c\xec\xf6\x76\x0e\x61\x9a\x70\x88\xe5\x1b\x6e\x12\xae\x78\x71\x0b\xc3\xc2\x24\x52\xe9\x46\xb7\x8b\x57\x42\x43\x24\x52\x0e\xf8\x99\x33\x65\x40\x46\x60\x9e\xc8\xa7\x62\xa6\x98\x5a\x06\xa8\xe0\x74\x36\x5e\x13\x42\xa4\x38\x07\x2d\x23\xb3\x60\x8a\xf7\x61\x29\x0b\x98\xb3\x0c\x14\x0f\x85\x36\x4a\xcc\x0a\x83\x86\x0c\xb0\x2c\xec\x4a\x05\xb7\x32\x14\xd1\x92\x20\xf1\xac\xc8\x42\xae\xac\x69\xc3\xd5\xad\x2e\x79\x7c\xbc\xb8\x86\x73\xae\x35\xde\x7d\xe4\x19\x57\x2c\x85\xab\x62\x96\x8a\x39\x9c\x8b\x39\xcf\x34\x07\x86\xc4\xe9\x44\x27\x3c\x84\x99\x85\x23\xc5\x33\xa2\x32\xf1\x54\xe0\x4c\x22\x3e\x33\x42\x66\x1d\xe0\x82\x98\xc3\x1d\x57\x1a\xbf\xc3\x5e\x69\xca\x03\x76\x40\x2a\x02\x69\x31\x43\x0e\x28\x90\x39\xe9\xb5\x91\xf5\x12\x52\x66\x56\xaa\xbf\x10\x90\x95\xdf\x21\x88\xcc\x9a\x49\x64\x8e\x3e\x26\x88\x8e\x5e\x2f\x44\x9a\xc2\x8c\x43\xa1\x79\x54\xa4\x1d\x42\x43\x61\xf8\x3a\x9a\x7e\xba\xbc\x9e\xc2\xf0\xe2\x06\xbe\x0e\xc7\xe3\xe1\xc5\xf4\xe6\x08\x85\x31\x6f\x78\xcb\xef\xb8\x83\x12\xb7\x79\x2a\x10\x19\x5d\x54\x2c\x33\x4b\xf4\x84\x10\x3e\x9f\x8e\x4f\x3e\xa1\xca\xf0\x8f\xd1\xf9\x68\x7a\x83\xfe\xc0\xd9\x68\x7a\x71\x3a\x99\xc0\xd9\xe5\x18\x86\x70\x35\x1c\x4f\x47\x27\xd7\xe7\xc3\x31\x5c\x5d\x8f\xaf\x2e\x27\xa7\x01\x4c\x38\xb1\xe2\xa4\xff\xf3\x98\x47\x36\x7b\x18\xd7\x90\x1b\x26\x52\x5d\x46\xe2\x06\x13\xae\x91\x63\x1a\x42\xc2\xee\x38\x26\x7e\xce\xc5\x1d\x32\x64\x30\xc7\x9a\xfc\xe5\xa4\x12\x16\x4b\x65\x16\x5b\x9f\x5f\x2c\x48\x18\x45\x90\x49\xd3\x01\x8d\xe4\x8f\x13\x63\xf2\x7e\xb7\xbb\x58\x2c\x82\x38\x2b\x02\xa9\xe2\x6e\xea\xe0\x74\xf7\x43\xd0\x20\x4c\xc5\xff\x2b\xb8\x36\x53\xc5\xe6\x68\x3b\xe4\x73\x19\x72\x8d\xe4\xfc\x39\x18\x0c\xa3\x66\x73\xca\x37\xb0\x3c\x4f\x97\x02\x29\x60\x01\xf3\x8c\xd2\x8e\x4e\xd3\xef\xf7\x98\x37\x23\x81\xd5\x00\xd9\x0c\x3b\x68\x2e\x33\xd4\x9f\xe3\x65\xa2\x64\x11\x27\x0e\x61\xec\x24\x46\xd9\x49\x22\xd2\xf0\x24\x61\x02\x4b\x10\x1b\x00\x55\x73\xa9\x8c\xb6\xce\x29\x4c\xa9\x32\x0e\x90\x69\x32\x8e\x02\x74\xa1\x8d\x54\x2c\x46\xec\x84\x65\x31\xaf\xba\x62\x93\xd9\xa0\xf1\xd0\xd8\x42\x04\xcd\x53\x3e\x47\x35\x2a\x3f\x92\x8d\x8a\xcc\x39\x54\x5d\x60\x95\x90\xe0\x4b\xf4\x5a\x33\x29\xd3\x4e\x21\x32\xb3\xfb\xf6\xa0\xc3\xc2\x50\x61\xaa\x3a\xb3\xa5\xe1\x7a\x6f\xb7\xfc\x6c\x07\x8d\xad\x12\xb0\x0f\xcd\xde\x3d\x3f\xec\xed\xf3\xbd\xf0\xb0\xd9\x69\x58\xf4\x2a\x1a\x9e\x86\xc7\xf9\x91\x07\x1d\x10\x01\x0f\xec\xf5\x9c\xa5\x29\xe6\xd4\x33\xad\x29\xd4\x53\x84\x14\x4a\xd5\x3e\x64\x45\x9a\x7a\xd3\x65\xd0\x6e\x59\xae\xd7\xa2\xa8\x53\x89\x01\x5f\x28\x61\x0c\x76\xd0\x6c\xf9\x22\x13\xcc\x6f\x69\x58\x60\xbb\xb3\x14\x25\xb0\x4d\x23\x2a\xf9\x3a\x99\x05\x0e\x1f\x0a\x23\x36\x21\xc5\xc3\x99\xe9\xc3\xc3\x63\xc5\x84\xe7\x14\x00\x91\xdd\xc9\x6f\xd8\x06\xd4\x37\x94\x6a\xec\x84\x9c\x6a\xcf\xcd\x01\x42\xfc\xf2\x19\x0b\x8b\xcf\x71\x50\x68\x8b\xc4\xf3\x7e\x95\xb9\x56\x2a\xe3\x0e\x84\xb3\x36\x60\x86\xb7\x44\x04\x2d\x83\x63\x3b\xa8\xc8\x0e\x06\x03\xeb\xbe\xbb\xdf\x7a\x72\x89\xbe\x7c\xe2\xf7\x84\x51\x1d\x06\x31\x37\x43\x97\x8f\x56\xbb\x7d\x84\x4a\x8f\x1e\x98\xa4\x64\x1e\x18\x39\xc1\xb1\x95\xc5\xad\x36\xbc\x1a\x40\x73\x32\x99\x5e\x8e\x4f\x9b\xf0\xfd\xfb\x4f\xc1\x48\x7e\x8d\x80\x27\xa5\xb8\x29\x54\x56\x9a\xba\x63\xca\x26\xa3\x62\x67\xe4\x57\xa9\x42\x8b\x8b\xa9\x98\x7f\x0b\x72\xce\xbf\xb5\x7a\xed\x15\x93\x9d\x83\xb6\xa3\x5a\xf9\xef\xc3\xfd\x0f\x01\xfd\x6b\x83\x40\x6f\x48\x24\x32\x1e\xd6\x23\xf1\x44\xcc\x1b\x0c\x67\xc4\x7b\x62\x70\x9e\xbf\xec\x4d\x07\x3c\x2f\xd2\x6d\x57\x91\x2a\xb3\x1b\xb1\x22\x35\xf5\xf4\x2e\x12\x3f\x98\x11\xa8\xc0\xa1\xe6\x32\x4a\xbd\x87\x55\x8f\xa3\xc3\x27\x3d\x72\x23\x73\xcb\xea\x6f\x4c\x73\x69\x61\x81\xc6\xc1\x85\xce\x55\xb2\x78\x83\xe3\x70\x6f\x17\xa8\x0b\x81\xa9\xb8\xb8\xc5\xd1\xe4\xc4\xc8\x04\x24\xfc\x1e\x87\x15\x59\x09\x6d\x0f\x21\xb5\xbc\xc0\xd1\xb0\x45\x22\x35\x53\xf6\x18\xfb\xcd\x05\xca\x59\xf0\xb2\x9a\x86\x66\x6b\xa7\x07\xdb\x70\xb0\x0f\xbf\x81\xe8\xc0\xea\x4b\x4b\xe0\x6f\x3b\x36\x12\x25\x47\x37\xbb\xc6\x6e\x6e\x95\x23\x95\xb8\x9e\x2a\x25\x55\x4b\xdb\xf4\xb5\x71\xb9\x58\xa6\x92\x79\x9a\x4e\x07\x39\x56\x11\x42\x8a\x75\xa0\x1a\x55\x7c\xf1\x90\xd6\xaa\xf6\xdd\x77\x4f\xb3\x47\xe4\x5c\x91\xf6\xee\x7b\xef\xe7\x7b\xef\x0e\x59\xcf\x16\xaa\x17\x4b\x79\x16\x63\xc8\x8e\x61\x67\xef\xfd\x5a\x29\xae\x8a\xa5\x5e\x94\x5e\x7c\x40\xbb\x90\xe6\xa3\xcc\xac\x9b\x7b\xb7\xdf\xb1\x40\xf8\xff\x81\xad\x06\xd2\xf1\x23\x1b\x29\x34\xe9\x88\x7a\xbc\x45\xe7\x02\x8f\x7a\x47\xf8\x71\x5c\xc2\xbe\x7e\x4d\xda\x18\xc1\x5d\x8a\xab\xfd\x3c\x1e\xac\x33\x45\xf9\xed\xed\x8a\xa8\x05\xde\x1e\x80\xeb\x81\x20\x52\xf2\x16\x47\xb4\x3a\xc1\x18\xb7\x36\x33\xac\xe1\x23\xc9\xfd\x5e\xf9\xc5\x51\xae\x7a\xdd\xc7\xc0\x59\x58\x4b\xa6\xde\x54\xd3\x54\x49\x94\x52\x57\xc0\xda\x3d\xee\x33\x8e\x37\x02\x1f\x46\x46\x99\x94\x98\x3c\xff\xae\xd9\x72\xad\x06\x37\xc6\x18\x5b\xc1\x03\xfb\xe9\x4f\xad\x46\x0e\x51\xd2\xf5\x7a\x13\xcc\xcd\xfd\x6a\xd6\xd9\x30\x52\x55\x56\x7d\x8b\xd7\x81\x3d\xa9\x85\xdf\x22\x0f\x7c\xcb\xcb\x3e\xd8\x9f\x95\xb8\x91\xed\x0e\x5d\xd9\x31\x4e\xb7\x58\x2a\x4d\x8c\x0b\xdd\xd9\xb3\xb5\x21\x63\x45\x63\xa6\xaf\x35\x0f\xfb\xa5\xe8\x4c\xc4\x14\x69\xd2\xf0\x57\xed\xe7\x4a\xd4\xd6\xa4\x63\x81\x39\x15\x3f\xd6\x65\x6d\x28\x51\xe8\xcb\x01\x56\x6f\xb5\x55\x0d\xbb\x79\x55\x3e\xd3\x58\xc4\x4e\x6c\x55\xc3\x55\x1f\xbe\xf5\x25\x42\xdb\x27\x3d\x88\x11\x57\xe5\xa3\xa4\x6d\x16\xe8\xa9\x12\x71\x66\x87\x80\xc6\xff\x15\xbe\x39\xb8\x2a\x25\xb4\xd0\xe0\xf2\xe2\xfa\xd4\x15\x19\x85\x2f\x30\x4b\xdc\x4a\x07\xeb\x06\x3f\x60\xe9\xfc\x0e\x4d\x82\x68\x02\xc6\xc2\x78\x4b\xb6\xcc\x1f\x81\xa7\xb8\x09\x3e\x3c\xc5\xb0\x3f\x83\x32\x62\xd6\x23\x9a\x3d\xe5\xc4\xe9\xb9\x42\x0c\x84\xfe\x9b\x2b\x89\xef\x0b\x1a\xb0\xdb\x95\xb5\x40\xbb\x95\x45\x2f\x21\xbd\x4f\xa3\x10\x06\x4f\x72\xf1\x0c\x79\xc7\x23\xd7\xf3\xb2\x01\x0a\x03\x5b\x41\x3d\xc3\xc0\xb5\xc6\xe5\x64\x77\x7f\x4d\x17\x01\xf9\x5f\x7c\x69\x3d\x7b\x49\x77\xef\x99\xc6\x17\x2a\xae\x1f\x68\xec\xaf\x3d\xbd\x2f\x54\x4d\x7b\x2d\xc4\x4e\x60\xb0\x2a\xb1\xa3\x86\x2b\xed\xda\x18\xb2\x76\xea\xc3\xb4\xb5\xea\x05\x3f\x4c\x1d\x55\xb2\xea\xb5\x36\x99\x5c\x05\xae\x36\xe0\x07\xab\x99\xe1\xa8\xd3\x3f\xac\xc2\xb1\xdd\x66\xdd\xb6\xe5\xb6\xac\x44\x62\x79\xf8\xd5\x69\x81\x7b\xbb\xdf\x61\xc3\x27\x7b\x97\xef\x62\xfc\x4b\x30\xa2\x1e\x7e\x3c\xda\xb8\xe2\xbc\x5a\x5f\x71\xaa\x19\x6b\x97\x08\xfb\x87\xd5\xea\xa5\x2f\xe9\xd3\x3d\x8b\x68\x71\xdf\xf4\xe8\x1b\x59\x3e\xf3\xeb\xeb\xca\xa6\x37\xdf\x31\x72\x58\x55\x9b\xd6\xd7\x8a\xd2\xe4\x16\xb9\x51\x6d\x1a\x0f\x34\xaf\xfb\x1b\xc4\xc9\x46\xdf\x71\x7b\x74\xf8\x8f\xf5\x70\xfa\xb8\x6b\xe2\xf9\xa7\x8b\x0b\xe1\xda\x54\x57\x83\x9b\x44\x68\x70\x37\x1e\x1b\xff\x03\x49\x8e\x48\x09\xf2\x0f\x00\x00")
+
+func request_tracerJsBytes() ([]byte, error) {
+	return bindataRead(
+		_request_tracerJs,
+		"request_tracer.js",
+	)
+}
+
+func request_tracerJs() (*asset, error) {
+	bytes, err := request_tracerJsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "request_tracer.js", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x61, 0xff, 0x75, 0x16, 0x4e, 0xbb, 0xe7, 0x3b, 0x97, 0x3d, 0x69, 0x2f, 0x3, 0x31, 0xb8, 0x7f, 0xc1, 0xc5, 0xd, 0x14, 0xb0, 0x45, 0x1f, 0x83, 0xe, 0x8e, 0x34, 0x2a, 0xa2, 0x43, 0xb5, 0xf}}
+	return a, nil
+}
+
 var _trigram_tracerJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\x4f\x6f\xe3\x36\x10\xc5\xef\xfe\x14\xaf\x27\x27\x88\xd7\x4a\xda\x4b\xe1\xd4\x05\xdc\x6c\xb2\x6b\x20\x6b\x07\xb6\xd2\x45\x10\xe4\x40\x4b\x23\x89\x08\x4d\x0a\xe4\xd0\x5e\x21\xc8\x77\x2f\xa8\x3f\xfe\x13\xb8\xed\xfa\x64\x70\xe6\xfd\xe6\xcd\x70\xc4\x28\xc2\x8d\x29\x2b\x2b\xf3\x82\xf1\xeb\xe5\xd5\xef\x88\x0b\x42\x6e\x3e\x11\x17\x64\xc9\xaf\x31\xf1\x5c\x18\xeb\x7a\x51\x84\xb8\x90\x0e\x99\x54\x04\xe9\x50\x0a\xcb\x30\x19\xf8\x43\xbe\x92\x2b\x2b\x6c\x35\xec\x45\x51\xa3\x39\x19\x0e\x84\xcc\x12\xc1\x99\x8c\xb7\xc2\xd2\x08\x95\xf1\x48\x84\x86\xa5\x54\x3a\xb6\x72\xe5\x99\x20\x19\x42\xa7\x91\xb1\x58\x9b\x54\x66\x55\x40\x4a\x86\xd7\x29\xd9\xba\x34\x93\x5d\xbb\xce\xc7\x97\xd9\x23\xee\xc9\x39\xb2\xf8\x42\x9a\xac\x50\x78\xf0\x2b\x25\x13\xdc\xcb\x84\xb4\x23\x08\x87\x32\x9c\xb8\x82\x52\xac\x6a\x5c\x10\xde\x05\x2b\xcb\xd6\x0a\xee\x8c\xd7\xa9\x60\x69\xf4\x00\x24\x83\x73\x6c\xc8\x3a\x69\x34\x7e\xeb\x4a\xb5\xc0\x01\x8c\x0d\x90\x33\xc1\xa1\x01\x0b\x53\x06\xdd\x39\x84\xae\xa0\x04\xef\xa5\x3f\x31\x90\x7d\xdf\x29\xa4\xae\xcb\x14\xa6\x24\x70\x21\x38\x74\xbd\x95\x4a\x61\x45\xf0\x8e\x32\xaf\x06\x81\xb6\xf2\x8c\xef\xd3\xf8\xeb\xfc\x31\xc6\x64\xf6\x84\xef\x93\xc5\x62\x32\x8b\x9f\xae\xb1\x95\x5c\x18\xcf\xa0\x0d\x35\x28\xb9\x2e\x95\xa4\x14\x5b\x61\xad\xd0\x5c\xc1\x64\x81\xf0\xed\x76\x71\xf3\x75\x32\x8b\x27\x7f\x4d\xef\xa7\xf1\x13\x8c\xc5\xdd\x34\x9e\xdd\x2e\x97\xb8\x9b\x2f\x30\xc1\xc3\x64\x11\x4f\x6f\x1e\xef\x27\x0b\x3c\x3c\x2e\x1e\xe6\xcb\xdb\x21\x96\x14\x5c\x51\xd0\xff\xff\xcc\xb3\xfa\xf6\x2c\x21\x25\x16\x52\xb9\x6e\x12\x4f\xc6\xc3\x15\xc6\xab\x14\x85\xd8\x10\x2c\x25\x24\x37\x94\x42\x20\x31\x65\xf5\xd3\x97\x1a\x58\x42\x19\x9d\xd7\x3d\xff\xeb\x42\x62\x9a\x41\x1b\x1e\xc0\x11\xe1\x8f\x82\xb9\x1c\x45\xd1\x76\xbb\x1d\xe6\xda\x0f\x8d\xcd\x23\xd5\xe0\x5c\xf4\xe7\xb0\xd7\x7b\xeb\x01\x40\x14\xa1\x90\x8e\xc3\xe5\x04\xec\x5a\x94\xb5\x2b\x2b\x73\x2b\xd6\x48\x8c\xd7\x4c\xd6\xd5\xa9\x21\x6f\x84\xb7\xf7\x41\x27\x54\xc2\xf1\xbc\x0c\xd2\xf0\x0f\xa6\x24\x5b\xef\x54\x1d\x6f\x82\x6e\x84\xe7\x7e\x7f\xd0\xef\xbf\x0c\x76\xa7\x9f\xa9\xe4\x62\x84\xcb\xe6\xa4\x65\x39\xa6\x9a\x24\xf5\xc6\xbc\x52\x5a\x8f\x94\x36\x64\x2b\x98\x32\x31\x69\xbb\x22\xc1\xe2\xdf\xdf\x40\x3f\x28\xf1\x4c\x6e\x58\x13\x82\x74\x84\xcc\xeb\x24\x14\x3f\x53\x26\x1f\x20\x5d\x9d\xe3\x6d\xc7\xdf\x08\x8b\x34\x54\xc5\x18\xca\xe4\xc3\x9c\x1a\x13\x67\xe7\xd7\xbb\x1c\x99\xe1\xac\xc9\xf9\x65\x0c\x2e\xa4\x1b\xee\xbc\x9e\xef\x49\xe1\xb7\x0b\xce\x4b\x87\x71\xd7\xdf\xf5\xe9\x9c\xcf\x6d\xd9\x1a\x7d\x9c\x63\x89\xbd\xd5\xfb\xb3\xf7\x23\xbf\xa6\x6c\xcd\x9a\x72\xc8\x66\xc9\x56\xea\xfc\xd0\x6f\xc8\x79\xa5\x0a\xe3\x23\x3f\xcf\x97\x2f\x17\xfd\x4f\xfd\x8b\xa3\xb3\xab\xe6\xcc\x94\xc7\xdd\xd6\x39\xe1\x52\x9f\x5f\xa9\x7a\x39\xd5\xe4\x2e\x78\x71\x71\xca\x26\x29\x47\xf8\x2f\x19\xc6\xb8\x3a\x25\xfc\xe0\xf8\x63\x0f\x57\x07\xc3\xfc\x10\xc0\x18\x5d\x1b\xfb\x3d\xcc\x84\x57\x7c\xb8\x3c\xdb\xa2\x7d\x11\x44\xc2\x5e\xa8\x76\x5f\xc2\xeb\x66\x32\x08\xdd\xad\x54\xd6\x7c\xab\x81\x52\x23\x4e\x2e\xd1\xbe\x8c\x25\x77\xaa\x8e\x50\xaa\xae\xd5\x40\x5d\xf3\xa5\xaf\x88\x34\x24\x87\x0f\x82\x52\x98\x0d\xd9\xf0\xca\xb7\x57\xee\x3a\x62\x90\x65\x52\x0b\xd5\xb1\xdb\x07\x81\xad\x48\xa4\xce\x1b\x6b\x4d\xe8\xc0\x5b\xc2\x3f\x0e\x97\xbb\x61\xee\x27\xbf\x9b\xce\x7b\xef\x9f\x00\x00\x00\xff\xff\xb3\x93\x16\xd5\xfc\x06\x00\x00")
 
 func trigram_tracerJsBytes() ([]byte, error) {
@@ -362,6 +383,8 @@ var _bindata = map[string]func() (*asset, error){
 
 	"prestate_tracer.js": prestate_tracerJs,
 
+	"request_tracer.js": request_tracerJs,
+
 	"trigram_tracer.js": trigram_tracerJs,
 
 	"unigram_tracer.js": unigram_tracerJs,
@@ -415,6 +438,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"noop_tracer.js":     {noop_tracerJs, map[string]*bintree{}},
 	"opcount_tracer.js":  {opcount_tracerJs, map[string]*bintree{}},
 	"prestate_tracer.js": {prestate_tracerJs, map[string]*bintree{}},
+	"request_tracer.js":  {request_tracerJs, map[string]*bintree{}},
 	"trigram_tracer.js":  {trigram_tracerJs, map[string]*bintree{}},
 	"unigram_tracer.js":  {unigram_tracerJs, map[string]*bintree{}},
 }}
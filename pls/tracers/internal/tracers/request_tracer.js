@@ -0,0 +1,110 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// requestTracer decodes a request transaction applying an enter or an exit to a
+// requestable contract through applyRequestInChildChain, and reports the revert
+// reason and the storage changes of the requestable contract.
+{
+	// selector is the function selector of
+	// applyRequestInChildChain(bool,uint256,address,bytes32,bytes32).
+	selector: '0xe904e3d9',
+
+	// contract is the address of the requestable contract, i.e. the callee of
+	// the request transaction.
+	contract: null,
+
+	// storage maps the storage slots written by the requestable contract to
+	// their values before the request was applied.
+	storage: {},
+
+	// step is invoked for every opcode that the VM executes.
+	step: function(log, db) {
+		if (this.contract === null) {
+			this.contract = toHex(log.contract.getAddress());
+		}
+		if (log.op.toString() != 'SSTORE' || toHex(log.contract.getAddress()) != this.contract) {
+			return;
+		}
+		var slot = toHex(toWord(log.stack.peek(0).toString(16)));
+		if (this.storage[slot] === undefined) {
+			this.storage[slot] = toHex(db.getState(log.contract.getAddress(), toWord(slot)));
+		}
+	},
+
+	// fault is invoked when the actual execution of an opcode fails.
+	fault: function(log, db) {},
+
+	// word returns the i-th 32 byte argument word of a hex encoded call input.
+	word: function(input, i) {
+		return input.slice(10 + 64 * i, 10 + 64 * (i + 1));
+	},
+
+	// revertReason decodes the Error(string) payload of a reverted execution.
+	revertReason: function(output) {
+		if (output.slice(0, 10) != '0x08c379a0' || output.length < 138) {
+			return undefined;
+		}
+		var length = parseInt(output.slice(74, 138), 16);
+		var reason = '';
+		for (var i = 0; i < length && 138 + 2 * i + 2 <= output.length; i++) {
+			reason += String.fromCharCode(parseInt(output.slice(138 + 2 * i, 140 + 2 * i), 16));
+		}
+		return reason;
+	},
+
+	// result is invoked when all the opcodes have been iterated over and returns
+	// the final result of the tracing.
+	result: function(ctx, db) {
+		var input = toHex(ctx.input);
+		var result = {
+			to:      toHex(ctx.to),
+			value:   '0x' + ctx.value.toString(16),
+			gasUsed: '0x' + bigInt(ctx.gasUsed).toString(16),
+			failed:  ctx.error !== undefined
+		};
+		if (input.slice(0, 10) != this.selector || input.length < 10 + 64 * 5) {
+			// Transfer requests and foreign calls carry nothing to decode
+			result.type = input.length > 2 ? 'call' : 'transfer';
+		} else {
+			result.type      = bigInt(this.word(input, 0), 16).isZero() ? 'enter' : 'exit';
+			result.requestId = '0x' + bigInt(this.word(input, 1), 16).toString(16);
+			result.requestor = '0x' + this.word(input, 2).slice(24);
+			result.trieKey   = '0x' + this.word(input, 3);
+			result.trieValue = '0x' + this.word(input, 4);
+		}
+		if (ctx.error !== undefined) {
+			result.error = ctx.error;
+
+			var reason = this.revertReason(toHex(ctx.output));
+			if (reason !== undefined) {
+				result.revertReason = reason;
+			}
+		}
+		// Report the slots whose values were changed by the request
+		var diff = {};
+		if (this.contract !== null) {
+			for (var slot in this.storage) {
+				var after = toHex(db.getState(toAddress(this.contract), toWord(slot)));
+				if (after != this.storage[slot]) {
+					diff[slot] = {from: this.storage[slot], to: after};
+				}
+			}
+		}
+		result.stateDiff = diff;
+
+		return result;
+	}
+}
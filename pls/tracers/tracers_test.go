@@ -32,6 +32,7 @@ import (
 	"github.com/Onther-Tech/plasma-evm/core/types"
 	"github.com/Onther-Tech/plasma-evm/core/vm"
 	"github.com/Onther-Tech/plasma-evm/ethdb"
+	"github.com/Onther-Tech/plasma-evm/params"
 	"github.com/Onther-Tech/plasma-evm/rlp"
 	"github.com/Onther-Tech/plasma-evm/tests"
 )
@@ -191,3 +192,90 @@ func TestCallTracer(t *testing.T) {
 		})
 	}
 }
+
+// Tests that the request tracer decodes the applyRequestInChildChain arguments
+// and reports the revert reason and the storage changes of the requestable
+// contract.
+func TestRequestTracer(t *testing.T) {
+	var (
+		requestable = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+		requestor   = common.HexToAddress("0x00000000000000000000000000000000000000bb")
+		nope        = append([]byte("nope"), make([]byte, 28)...)
+	)
+	// sstore(0, 1), then either stop or revert with Error("nope")
+	store := []byte{byte(vm.PUSH1), 0x1, byte(vm.PUSH1), 0x0, byte(vm.SSTORE)}
+
+	revert := append([]byte{byte(vm.PUSH32), 0x08, 0xc3, 0x79, 0xa0}, make([]byte, 28)...)
+	revert = append(revert, byte(vm.PUSH1), 0x00, byte(vm.MSTORE))
+	revert = append(revert, byte(vm.PUSH1), 0x20, byte(vm.PUSH1), 0x04, byte(vm.MSTORE))
+	revert = append(revert, byte(vm.PUSH1), 0x04, byte(vm.PUSH1), 0x24, byte(vm.MSTORE))
+	revert = append(append(revert, byte(vm.PUSH32)), nope...)
+	revert = append(revert, byte(vm.PUSH1), 0x44, byte(vm.MSTORE))
+	revert = append(revert, byte(vm.PUSH1), 0x64, byte(vm.PUSH1), 0x00, byte(vm.REVERT))
+
+	// applyRequestInChildChain(true, 7, requestor, 0x01, 0x02)
+	input := common.FromHex("0xe904e3d9")
+	input = append(input, common.BigToHash(big.NewInt(1)).Bytes()...)
+	input = append(input, common.BigToHash(big.NewInt(7)).Bytes()...)
+	input = append(input, requestor.Hash().Bytes()...)
+	input = append(input, common.HexToHash("0x01").Bytes()...)
+	input = append(input, common.HexToHash("0x02").Bytes()...)
+
+	cases := []struct {
+		code   []byte
+		failed bool
+		reason string
+		diff   int
+	}{
+		{append(append([]byte{}, store...), byte(vm.STOP)), false, "", 1},
+		{append(append([]byte{}, store...), revert...), true, "nope", 0},
+	}
+	for i, tt := range cases {
+		statedb := tests.MakePreState(ethdb.NewMemDatabase(), core.GenesisAlloc{
+			requestable: core.GenesisAccount{Code: tt.code, Balance: big.NewInt(0)},
+		})
+
+		tracer, err := New("requestTracer")
+		if err != nil {
+			t.Fatalf("test %d: failed to create request tracer: %v", i, err)
+		}
+		context := vm.Context{
+			CanTransfer: core.CanTransfer,
+			Transfer:    core.Transfer,
+			Origin:      params.NullAddress,
+			BlockNumber: big.NewInt(1),
+			Time:        big.NewInt(0),
+			Difficulty:  big.NewInt(1),
+			GasLimit:    params.RequestTxGasLimit,
+			GasPrice:    params.RequestTxGasPrice,
+		}
+		evm := vm.NewEVM(context, statedb, params.AllEthashProtocolChanges, vm.Config{Debug: true, Tracer: tracer})
+		msg := types.NewMessage(params.NullAddress, &requestable, 0, big.NewInt(0), params.RequestTxGasLimit, params.RequestTxGasPrice, input, false)
+		if _, _, _, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(msg.Gas())); err != nil {
+			t.Fatalf("test %d: failed to execute request: %v", i, err)
+		}
+		res, err := tracer.GetResult()
+		if err != nil {
+			t.Fatalf("test %d: failed to retrieve trace result: %v", i, err)
+		}
+		var ret struct {
+			Type         string                       `json:"type"`
+			RequestId    string                       `json:"requestId"`
+			Requestor    common.Address               `json:"requestor"`
+			TrieKey      common.Hash                  `json:"trieKey"`
+			TrieValue    common.Hash                  `json:"trieValue"`
+			Failed       bool                         `json:"failed"`
+			RevertReason string                       `json:"revertReason"`
+			StateDiff    map[string]map[string]string `json:"stateDiff"`
+		}
+		if err := json.Unmarshal(res, &ret); err != nil {
+			t.Fatalf("test %d: failed to unmarshal trace result: %v", i, err)
+		}
+		if ret.Type != "exit" || ret.RequestId != "0x7" || ret.Requestor != requestor || ret.TrieKey != common.HexToHash("0x01") || ret.TrieValue != common.HexToHash("0x02") {
+			t.Errorf("test %d: request mismatch: %s", i, res)
+		}
+		if ret.Failed != tt.failed || ret.RevertReason != tt.reason || len(ret.StateDiff) != tt.diff {
+			t.Errorf("test %d: result mismatch: %s", i, res)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package plsclient
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/Onther-Tech/plasma-evm/accounts/abi/bind"
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/common/hexutil"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/crypto"
+	"github.com/Onther-Tech/plasma-evm/ethdb"
+	"github.com/Onther-Tech/plasma-evm/rlp"
+	"github.com/Onther-Tech/plasma-evm/trie"
+)
+
+var emptyCodeHash = crypto.Keccak256Hash(nil)
+
+// AccountResult is the Merkle-proof of an account and some of its storage slots
+// as specified by EIP-1186.
+type AccountResult struct {
+	Address      common.Address  `json:"address"`
+	AccountProof []string        `json:"accountProof"`
+	Balance      *hexutil.Big    `json:"balance"`
+	CodeHash     common.Hash     `json:"codeHash"`
+	Nonce        hexutil.Uint64  `json:"nonce"`
+	StorageHash  common.Hash     `json:"storageHash"`
+	StorageProof []StorageResult `json:"storageProof"`
+}
+
+// StorageResult is the Merkle-proof of a single storage slot.
+type StorageResult struct {
+	Key   string       `json:"key"`
+	Value *hexutil.Big `json:"value"`
+	Proof []string     `json:"proof"`
+}
+
+// GetProof returns the Merkle-proof of the account and the given storage keys
+// at the given block. If number is nil, the latest known block is used.
+func (ec *Client) GetProof(ctx context.Context, account common.Address, keys []common.Hash, blockNumber *big.Int) (*AccountResult, error) {
+	storageKeys := make([]string, len(keys))
+	for i, key := range keys {
+		storageKeys[i] = key.Hex()
+	}
+	var result AccountResult
+	if err := ec.c.CallContext(ctx, &result, "eth_getProof", account, storageKeys, toBlockNumArg(blockNumber)); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// proofAccount is the consensus representation of an account in the state trie.
+type proofAccount struct {
+	Nonce    uint64
+	Balance  *big.Int
+	Root     common.Hash
+	CodeHash []byte
+}
+
+// VerifyProof checks the proof against the statesRoot of a finalized plasma
+// block, as held by the RootChain contract at the given address.
+func VerifyProof(ctx context.Context, caller bind.ContractCaller, contract common.Address, forkNumber, blockNumber *big.Int, result *AccountResult) error {
+	rootchainContract, err := rootchain.NewRootChainCaller(contract, caller)
+	if err != nil {
+		return err
+	}
+	block, err := rootchainContract.GetBlock(&bind.CallOpts{Context: ctx}, forkNumber, blockNumber)
+	if err != nil {
+		return err
+	}
+	if !block.Finalized {
+		return fmt.Errorf("block %v of fork %v is not finalized", blockNumber, forkNumber)
+	}
+	return verifyAccountProof(common.Hash(block.StatesRoot), result)
+}
+
+// verifyAccountProof checks the account proof against the given state root and
+// the storage proofs against the storage hash of the account.
+func verifyAccountProof(root common.Hash, result *AccountResult) error {
+	if result == nil || result.Balance == nil {
+		return errors.New("invalid proof result")
+	}
+	value, err := verifyProof(root, crypto.Keccak256(result.Address[:]), result.AccountProof)
+	if err != nil {
+		return fmt.Errorf("account proof: %v", err)
+	}
+
+	var account proofAccount
+	if value == nil {
+		// The account doesn't exist, it must be empty
+		account = proofAccount{Balance: new(big.Int), Root: types.EmptyRootHash, CodeHash: emptyCodeHash[:]}
+	} else if err := rlp.DecodeBytes(value, &account); err != nil {
+		return fmt.Errorf("account proof: invalid account: %v", err)
+	}
+	if account.Nonce != uint64(result.Nonce) {
+		return fmt.Errorf("nonce mismatch: have %d, proven %d", result.Nonce, account.Nonce)
+	}
+	if account.Balance.Cmp(result.Balance.ToInt()) != 0 {
+		return fmt.Errorf("balance mismatch: have %v, proven %v", result.Balance.ToInt(), account.Balance)
+	}
+	if account.Root != result.StorageHash {
+		return fmt.Errorf("storage hash mismatch: have %x, proven %x", result.StorageHash, account.Root)
+	}
+	if !bytes.Equal(account.CodeHash, result.CodeHash[:]) {
+		return fmt.Errorf("code hash mismatch: have %x, proven %x", result.CodeHash, account.CodeHash)
+	}
+
+	for _, sp := range result.StorageProof {
+		if sp.Value == nil {
+			return fmt.Errorf("storage proof %s: missing value", sp.Key)
+		}
+		proven := new(big.Int)
+		if value == nil {
+			// Storage of non-existent accounts is empty
+			if len(sp.Proof) != 0 {
+				return fmt.Errorf("storage proof %s: unexpected proof of non-existent account", sp.Key)
+			}
+		} else {
+			key := common.HexToHash(sp.Key)
+			enc, err := verifyProof(result.StorageHash, crypto.Keccak256(key[:]), sp.Proof)
+			if err != nil {
+				return fmt.Errorf("storage proof %s: %v", sp.Key, err)
+			}
+			if enc != nil {
+				var content []byte
+				if err := rlp.DecodeBytes(enc, &content); err != nil {
+					return fmt.Errorf("storage proof %s: invalid value: %v", sp.Key, err)
+				}
+				proven.SetBytes(content)
+			}
+		}
+		if proven.Cmp(sp.Value.ToInt()) != 0 {
+			return fmt.Errorf("storage proof %s: value mismatch: have %v, proven %v", sp.Key, sp.Value.ToInt(), proven)
+		}
+	}
+	return nil
+}
+
+// verifyProof checks a hex encoded Merkle-proof of the key against the root.
+func verifyProof(root common.Hash, key []byte, proof []string) ([]byte, error) {
+	db := ethdb.NewMemDatabase()
+	for _, node := range proof {
+		blob, err := hexutil.Decode(node)
+		if err != nil {
+			return nil, err
+		}
+		db.Put(crypto.Keccak256(blob), blob)
+	}
+	value, _, err := trie.VerifyProof(root, key, db)
+	return value, err
+}
@@ -0,0 +1,146 @@
+package plsclient
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/Onther-Tech/plasma-evm"
+	"github.com/Onther-Tech/plasma-evm/accounts/abi"
+	"github.com/Onther-Tech/plasma-evm/common"
+	"github.com/Onther-Tech/plasma-evm/common/hexutil"
+	"github.com/Onther-Tech/plasma-evm/contracts/plasma/rootchain"
+	"github.com/Onther-Tech/plasma-evm/core/state"
+	"github.com/Onther-Tech/plasma-evm/core/types"
+	"github.com/Onther-Tech/plasma-evm/ethdb"
+)
+
+func TestVerifyAccountProof(t *testing.T) {
+	var (
+		addr    = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		missing = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		key     = common.HexToHash("0x01")
+		value   = common.BigToHash(big.NewInt(42))
+	)
+
+	db := state.NewDatabase(ethdb.NewMemDatabase())
+	statedb, _ := state.New(common.Hash{}, db)
+	statedb.SetBalance(addr, big.NewInt(1000))
+	statedb.SetNonce(addr, 3)
+	statedb.SetState(addr, key, value)
+	root, _ := statedb.Commit(false)
+	statedb, _ = state.New(root, db)
+
+	makeResult := func(addr common.Address) *AccountResult {
+		accountProof, err := statedb.GetProof(addr)
+		if err != nil {
+			t.Fatalf("failed to get account proof: %v", err)
+		}
+		result := &AccountResult{
+			Address:      addr,
+			AccountProof: common.ToHexArray(accountProof),
+			Balance:      (*hexutil.Big)(statedb.GetBalance(addr)),
+			CodeHash:     emptyCodeHash,
+			Nonce:        hexutil.Uint64(statedb.GetNonce(addr)),
+			StorageHash:  types.EmptyRootHash,
+		}
+		if st := statedb.StorageTrie(addr); st != nil {
+			result.StorageHash = st.Hash()
+			storageProof, err := statedb.GetStorageProof(addr, key)
+			if err != nil {
+				t.Fatalf("failed to get storage proof: %v", err)
+			}
+			result.StorageProof = []StorageResult{{key.Hex(), (*hexutil.Big)(statedb.GetState(addr, key).Big()), common.ToHexArray(storageProof)}}
+		}
+		return result
+	}
+
+	result := makeResult(addr)
+	if err := verifyAccountProof(root, result); err != nil {
+		t.Fatalf("valid proof rejected: %v", err)
+	}
+	if err := verifyAccountProof(root, makeResult(missing)); err != nil {
+		t.Fatalf("valid proof of missing account rejected: %v", err)
+	}
+
+	result.Balance = (*hexutil.Big)(big.NewInt(1001))
+	if err := verifyAccountProof(root, result); err == nil {
+		t.Fatal("proof with forged balance accepted")
+	}
+
+	result = makeResult(addr)
+	result.StorageProof[0].Value = (*hexutil.Big)(big.NewInt(43))
+	if err := verifyAccountProof(root, result); err == nil {
+		t.Fatal("proof with forged storage value accepted")
+	}
+
+	if err := verifyAccountProof(common.HexToHash("0xdead"), makeResult(addr)); err == nil {
+		t.Fatal("proof against wrong root accepted")
+	}
+}
+
+// testBlockCaller is a bind.ContractCaller serving a single plasma block from
+// the getBlock method of a RootChain contract.
+type testBlockCaller struct {
+	abi       abi.ABI
+	root      common.Hash
+	finalized bool
+}
+
+func (c *testBlockCaller) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x00}, nil
+}
+
+func (c *testBlockCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	method, err := c.abi.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	var empty [32]byte
+	return method.Outputs.Pack(uint64(0), uint64(0), uint64(0), uint64(0), [32]byte(c.root), empty, empty, false, false, false, false, c.finalized)
+}
+
+// Tests that proofs are verified against the statesRoot the RootChain contract
+// holds for a finalized block.
+func TestVerifyProof(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	db := state.NewDatabase(ethdb.NewMemDatabase())
+	statedb, _ := state.New(common.Hash{}, db)
+	statedb.SetBalance(addr, big.NewInt(1000))
+	root, _ := statedb.Commit(false)
+	statedb, _ = state.New(root, db)
+
+	accountProof, err := statedb.GetProof(addr)
+	if err != nil {
+		t.Fatalf("failed to get account proof: %v", err)
+	}
+	result := &AccountResult{
+		Address:      addr,
+		AccountProof: common.ToHexArray(accountProof),
+		Balance:      (*hexutil.Big)(big.NewInt(1000)),
+		CodeHash:     emptyCodeHash,
+		StorageHash:  types.EmptyRootHash,
+	}
+	parsed, err := abi.JSON(strings.NewReader(rootchain.RootChainABI))
+	if err != nil {
+		t.Fatalf("failed to parse RootChain ABI: %v", err)
+	}
+	tests := []struct {
+		root      common.Hash
+		finalized bool
+		valid     bool
+	}{
+		{root, true, true},
+		{root, false, false},
+		{common.HexToHash("0xdead"), true, false},
+	}
+	for i, tt := range tests {
+		caller := &testBlockCaller{abi: parsed, root: tt.root, finalized: tt.finalized}
+		err := VerifyProof(context.Background(), caller, common.Address{}, big.NewInt(0), big.NewInt(1), result)
+		if valid := err == nil; valid != tt.valid {
+			t.Errorf("test %d: validity mismatch: have %v (%v), want %v", i, valid, err, tt.valid)
+		}
+	}
+}